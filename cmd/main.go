@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"log"
 	"os"
@@ -13,23 +14,52 @@ func main() {
 	concurrent := flag.Bool("concurrent", false, "Enable concurrent page processing")
 	workers := flag.Int("workers", 0, "Number of worker threads (0 = auto-detect, default: NumCPU)")
 	extractImages := flag.Bool("images", false, "Extract image metadata (width, height, position) from pages")
+	password := flag.String("password", "", "Password for encrypted PDFs (user or owner password)")
+	unmapped := flag.String("unmapped", "", "Replacement text for Type3 glyphs with no recoverable text (e.g. \uFFFD)")
+	segments := flag.Bool("segments", false, "Add words, lines and blocks with bounding boxes to each page")
+	readingOrder := flag.Bool("reading-order", false, "Order page text by layout (columns, blocks) instead of content stream order")
+	tables := flag.Bool("tables", false, "Detect tables and add their rows and cells to each page")
+	tableFormat := flag.String("table-format", "", "Render detected tables into page text as \"markdown\" or \"csv\" (implies --tables)")
 	flag.Parse()
 
 	if flag.NArg() < 1 {
-		log.Fatal("Usage: go-fast-pdf [--concurrent] [--workers N] [--images] <path_to_pdf>")
+		log.Fatal("Usage: go-fast-pdf [--concurrent] [--workers N] [--images] [--password PW] [--unmapped TEXT] [--segments] [--reading-order] [--tables] [--table-format markdown|csv] <path_to_pdf>")
 	}
 
 	path := flag.Arg(0)
 
+	opts := loader.Options{
+		ExtractImages: *extractImages,
+		Password:      *password,
+		UnmappedGlyph: *unmapped,
+		Segments:      *segments,
+		Tables:        *tables,
+	}
+	if *readingOrder {
+		opts.Layout = loader.LayoutReadingOrder
+	}
+	switch *tableFormat {
+	case "":
+	case "markdown":
+		opts.TableFormat = loader.TableFormatMarkdown
+	case "csv":
+		opts.TableFormat = loader.TableFormatCSV
+	default:
+		log.Fatalf("Unknown table format %q (use markdown or csv)", *tableFormat)
+	}
+
 	var err error
 	var doc any
 
 	if *concurrent {
-		doc, err = loader.LoadPDFConcurrent(path, *workers, *extractImages)
+		doc, err = loader.LoadPDFConcurrentWithOptions(path, *workers, opts)
 	} else {
-		doc, err = loader.LoadPDF(path, *extractImages)
+		doc, err = loader.LoadPDFWithOptions(path, opts)
 	}
 
+	if errors.Is(err, loader.ErrWrongPassword) {
+		log.Fatal("Failed to load PDF: incorrect password (use --password)")
+	}
 	if err != nil {
 		log.Fatalf("Failed to load PDF: %v", err)
 	}
@@ -11,6 +11,64 @@ import (
 	"github.com/AOShei/go-fast-pdf/pkg/pdf"
 )
 
+// ErrWrongPassword is returned when an encrypted document cannot be opened
+// with the supplied password.
+var ErrWrongPassword = pdf.ErrWrongPassword
+
+// LayoutMode selects how the text of a page is ordered in its Content.
+type LayoutMode int
+
+const (
+	// LayoutStream keeps text in content stream order.
+	LayoutStream LayoutMode = iota
+	// LayoutReadingOrder sorts text blocks into reading order, keeping the
+	// columns of multi-column pages apart. Blocks are separated by a blank
+	// line.
+	LayoutReadingOrder
+)
+
+// TableFormat selects how detected tables are written into a page's
+// Content.
+type TableFormat int
+
+const (
+	// TableFormatNone leaves Content as it would be without tables.
+	TableFormatNone TableFormat = iota
+	// TableFormatMarkdown writes each table as a Markdown pipe table.
+	TableFormatMarkdown
+	// TableFormatCSV writes each table as comma-separated values.
+	TableFormatCSV
+)
+
+// Options configures document loading.
+type Options struct {
+	// ExtractImages enables image metadata extraction.
+	ExtractImages bool
+	// Password opens encrypted documents. It may be either the user or
+	// the owner password.
+	Password string
+	// UnmappedGlyph replaces Type3 glyphs that have no recoverable text
+	// (bitmap or path-only glyphs with meaningless names). Empty keeps the
+	// default best-effort guess from the character code.
+	UnmappedGlyph string
+	// Limits bounds the resources spent on untrusted documents. Zero
+	// fields select the pdf.Default* values.
+	Limits pdf.Limits
+	// Cache bounds the object cache of each reader. Zero fields select
+	// the pdf.DefaultCache* values.
+	Cache pdf.CacheOptions
+	// Segments fills in the Words, Lines and Blocks of each page.
+	Segments bool
+	// Layout selects the order of the text in each page's Content.
+	Layout LayoutMode
+	// Tables fills in the Tables of each page.
+	Tables bool
+	// TableFormat, unless TableFormatNone, rebuilds each page's Content
+	// from its text blocks with the lines of each table replaced by the
+	// rendered table. It implies Tables.
+	TableFormat TableFormat
+}
+
 // pageResult holds the result of processing a single page
 type pageResult struct {
 	pageNum int
@@ -20,6 +78,11 @@ type pageResult struct {
 
 // LoadPDF takes a file path and returns the structured Document.
 func LoadPDF(path string, extractImages bool) (*model.Document, error) {
+	return LoadPDFWithOptions(path, Options{ExtractImages: extractImages})
+}
+
+// LoadPDFWithOptions takes a file path and returns the structured Document.
+func LoadPDFWithOptions(path string, opts Options) (*model.Document, error) {
 	// 1. Open File
 	f, err := os.Open(path)
 	if err != nil {
@@ -28,7 +91,7 @@ func LoadPDF(path string, extractImages bool) (*model.Document, error) {
 	defer f.Close()
 
 	// 2. Initialize the Low-Level Reader
-	reader, err := pdf.NewReader(f)
+	reader, err := pdf.NewReaderWithOptions(f, opts.readerOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pdf reader: %w", err)
 	}
@@ -36,29 +99,18 @@ func LoadPDF(path string, extractImages bool) (*model.Document, error) {
 	// 3. Extract Metadata
 	meta := model.Metadata{
 		Encrypted: reader.IsEncrypted(),
+		Security:  reader.Security(),
+		Repaired:  reader.Repaired(),
 	}
 
-	// Skip metadata extraction if encrypted (strings will be garbage)
-	if !meta.Encrypted {
-		if info, err := reader.GetInfo(); err == nil && info != nil {
-			if t, ok := info["/Title"].(pdf.StringObject); ok {
-				meta.Title = string(t)
-			}
-			if a, ok := info["/Author"].(pdf.StringObject); ok {
-				meta.Author = string(a)
-			}
-			if c, ok := info["/Creator"].(pdf.StringObject); ok {
-				meta.Creator = string(c)
-			}
-			if p, ok := info["/Producer"].(pdf.StringObject); ok {
-				meta.Producer = string(p)
-			}
-		}
-	}
+	readInfo(reader, &meta)
 
-	// Log if encrypted (attempting decryption with empty password)
+	// Log if encrypted
 	if meta.Encrypted {
-		fmt.Fprintf(os.Stderr, "PDF is encrypted. Attempting to decrypt with empty password (owner-password-only PDFs)...\n")
+		logEncrypted(opts)
+	}
+	if meta.Repaired {
+		fmt.Fprintf(os.Stderr, "Cross-reference table is damaged; rebuilt it by scanning the file\n")
 	}
 
 	doc := &model.Document{
@@ -80,51 +132,31 @@ func LoadPDF(path string, extractImages bool) (*model.Document, error) {
 			continue
 		}
 
-		// Initialize Extractor for this page
-		extractor, err := pdf.NewExtractor(reader, pdfPage, extractImages)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error creating extractor for page %d: %v\n", i+1, err)
-			continue
-		}
-
 		// Extract!
-		text, err := extractor.ExtractText()
+		page, err := extractPage(reader, pdfPage, i, opts)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error extracting text from page %d: %v\n", i+1, err)
 			continue
 		}
+		doc.Pages = append(doc.Pages, page)
 
-		// Basic dimensions (MediaBox)
-		var width, height float64
-		if mBox, ok := pdfPage["/MediaBox"].(pdf.ArrayObject); ok && len(mBox) == 4 {
-			// [x1 y1 x2 y2] -> width = x2-x1, height = y2-y1
-			// Simplified: assume x1,y1 are 0
-			if w, ok := mBox[2].(pdf.NumberObject); ok {
-				width = float64(w)
-			}
-			if h, ok := mBox[3].(pdf.NumberObject); ok {
-				height = float64(h)
-			}
-		}
-
-		doc.Pages = append(doc.Pages, model.Page{
-			PageNumber: i + 1,
-			Content:    text,
-			CharCount:  len(text),
-			Width:      width,
-			Height:     height,
-			Images:     extractor.GetImages(),
-		})
-
-		fmt.Fprintf(os.Stderr, "Page %d processed in %v (%d chars)\n", i+1, time.Since(start), len(text))
+		fmt.Fprintf(os.Stderr, "Page %d processed in %v (%d chars)\n", i+1, time.Since(start), page.CharCount)
 	}
 
+	doc.Metadata.Warnings = reader.Warnings()
+	logWarnings(doc.Metadata.Warnings)
+
 	return doc, nil
 }
 
 // LoadPDFConcurrent loads a PDF and extracts text using concurrent page processing.
 // The workers parameter specifies the number of concurrent workers (0 = auto-detect using NumCPU).
 func LoadPDFConcurrent(path string, workers int, extractImages bool) (*model.Document, error) {
+	return LoadPDFConcurrentWithOptions(path, workers, Options{ExtractImages: extractImages})
+}
+
+// LoadPDFConcurrentWithOptions is LoadPDFConcurrent with explicit options.
+func LoadPDFConcurrentWithOptions(path string, workers int, opts Options) (*model.Document, error) {
 	// 1. Open File to get metadata and page count
 	f, err := os.Open(path)
 	if err != nil {
@@ -132,8 +164,11 @@ func LoadPDFConcurrent(path string, workers int, extractImages bool) (*model.Doc
 	}
 	defer f.Close()
 
-	// 2. Initialize the Low-Level Reader
-	reader, err := pdf.NewReader(f)
+	// 2. Initialize the Low-Level Reader. The workers' readers share its
+	// decode counter, so MaxTotalDecoded bounds the document as a whole.
+	readerOpts := opts.readerOptions()
+	readerOpts.Decoded = new(pdf.DecodeCounter)
+	reader, err := pdf.NewReaderWithOptions(f, readerOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pdf reader: %w", err)
 	}
@@ -141,38 +176,29 @@ func LoadPDFConcurrent(path string, workers int, extractImages bool) (*model.Doc
 	// 3. Extract Metadata
 	meta := model.Metadata{
 		Encrypted: reader.IsEncrypted(),
+		Security:  reader.Security(),
+		Repaired:  reader.Repaired(),
 	}
 
-	if !meta.Encrypted {
-		if info, err := reader.GetInfo(); err == nil && info != nil {
-			if t, ok := info["/Title"].(pdf.StringObject); ok {
-				meta.Title = string(t)
-			}
-			if a, ok := info["/Author"].(pdf.StringObject); ok {
-				meta.Author = string(a)
-			}
-			if c, ok := info["/Creator"].(pdf.StringObject); ok {
-				meta.Creator = string(c)
-			}
-			if p, ok := info["/Producer"].(pdf.StringObject); ok {
-				meta.Producer = string(p)
-			}
-		}
-	}
+	readInfo(reader, &meta)
 
 	if meta.Encrypted {
-		fmt.Fprintf(os.Stderr, "PDF is encrypted. Attempting to decrypt with empty password (owner-password-only PDFs)...\n")
+		logEncrypted(opts)
+	}
+	if meta.Repaired {
+		fmt.Fprintf(os.Stderr, "Cross-reference table is damaged; rebuilt it by scanning the file\n")
 	}
 
 	numPages := reader.NumPages()
+	meta.Warnings = reader.Warnings()
 	fmt.Fprintf(os.Stderr, "Processing %d pages concurrently...\n", numPages)
 
 	// 4. Process pages concurrently
-	return loadPDFParallel(path, meta, numPages, workers, extractImages)
+	return loadPDFParallel(path, meta, numPages, workers, opts, readerOpts)
 }
 
 // loadPDFParallel implements the worker pool pattern for concurrent page extraction
-func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int, extractImages bool) (*model.Document, error) {
+func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int, opts Options, readerOpts pdf.ReaderOptions) (*model.Document, error) {
 	// 1. Determine worker count
 	if workers <= 0 {
 		workers = runtime.NumCPU()
@@ -187,6 +213,8 @@ func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int
 
 	// 3. Launch workers
 	var wg sync.WaitGroup
+	var warnMu sync.Mutex
+	warnings := meta.Warnings
 	for w := 0; w < workers; w++ {
 		wg.Add(1)
 		go func() {
@@ -206,7 +234,7 @@ func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int
 			defer f.Close()
 
 			// Create reader for this worker
-			reader, err := pdf.NewReader(f)
+			reader, err := pdf.NewReaderWithOptions(f, readerOpts)
 			if err != nil {
 				select {
 				case idx := <-pageIndices:
@@ -215,6 +243,11 @@ func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int
 				}
 				return
 			}
+			defer func() {
+				warnMu.Lock()
+				warnings = appendWarnings(warnings, reader.Warnings())
+				warnMu.Unlock()
+			}()
 
 			// Process pages from the channel
 			for pageIdx := range pageIndices {
@@ -226,39 +259,14 @@ func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int
 					continue
 				}
 
-				extractor, err := pdf.NewExtractor(reader, pdfPage, extractImages)
+				page, err := extractPage(reader, pdfPage, pageIdx, opts)
 				if err != nil {
 					results <- pageResult{pageNum: pageIdx, err: err}
 					continue
 				}
 
-				text, err := extractor.ExtractText()
-				if err != nil {
-					results <- pageResult{pageNum: pageIdx, err: err}
-					continue
-				}
-
-				var width, height float64
-				if mBox, ok := pdfPage["/MediaBox"].(pdf.ArrayObject); ok && len(mBox) == 4 {
-					if w, ok := mBox[2].(pdf.NumberObject); ok {
-						width = float64(w)
-					}
-					if h, ok := mBox[3].(pdf.NumberObject); ok {
-						height = float64(h)
-					}
-				}
-
-				page := model.Page{
-					PageNumber: pageIdx + 1,
-					Content:    text,
-					CharCount:  len(text),
-					Width:      width,
-					Height:     height,
-					Images:     extractor.GetImages(),
-				}
-
 				fmt.Fprintf(os.Stderr, "Page %d processed in %v (%d chars)\n",
-					pageIdx+1, time.Since(start), len(text))
+					pageIdx+1, time.Since(start), page.CharCount)
 
 				results <- pageResult{pageNum: pageIdx, page: page, err: nil}
 			}
@@ -300,8 +308,143 @@ func loadPDFParallel(path string, meta model.Metadata, numPages int, workers int
 		}
 	}
 
+	meta.Warnings = warnings
+	logWarnings(meta.Warnings)
+
 	return &model.Document{
 		Metadata: meta,
 		Pages:    validPages,
 	}, nil
 }
+
+// extractPage extracts the text, images and geometry of one page.
+func extractPage(reader *pdf.Reader, pdfPage pdf.DictionaryObject, pageIdx int, opts Options) (model.Page, error) {
+	extOpts := opts.extractorOptions()
+	var glyphs []pdf.Glyph
+	var rulings []pdf.Ruling
+	tables := opts.Tables || opts.TableFormat != TableFormatNone
+	if opts.Segments || opts.Layout == LayoutReadingOrder || tables {
+		extOpts.GlyphSink = func(g pdf.Glyph) { glyphs = append(glyphs, g) }
+	}
+	if tables {
+		extOpts.RulingSink = func(r pdf.Ruling) { rulings = append(rulings, r) }
+	}
+
+	extractor, err := pdf.NewExtractorWithOptions(reader, pdfPage, extOpts)
+	if err != nil {
+		return model.Page{}, err
+	}
+	text, err := extractor.ExtractText()
+	if err != nil {
+		return model.Page{}, err
+	}
+
+	page := model.Page{
+		PageNumber: pageIdx + 1,
+		Content:    text,
+		CharCount:  len(text),
+		Images:     extractor.GetImages(),
+	}
+	setGeometry(&page, reader.PageGeometry(pdfPage))
+	if extOpts.GlyphSink == nil {
+		return page, nil
+	}
+
+	layout := pdf.AnalyzeLayout(glyphs)
+	if opts.Layout == LayoutReadingOrder {
+		confidence := layout.ReadingOrder()
+		page.ReadingOrderConfidence = &confidence
+		page.Content = layout.Text()
+		page.CharCount = len(page.Content)
+	}
+	if tables {
+		page.Tables = layout.DetectTables(rulings)
+		switch opts.TableFormat {
+		case TableFormatMarkdown:
+			page.Content = layout.TextWithTables(model.Table.Markdown)
+		case TableFormatCSV:
+			page.Content = layout.TextWithTables(model.Table.CSV)
+		}
+		page.CharCount = len(page.Content)
+	}
+	if opts.Segments {
+		page.Words, page.Lines, page.Blocks = layout.Words, layout.Lines, layout.Blocks
+	}
+	return page, nil
+}
+
+// readerOptions translates loader options into low-level reader options.
+func (o Options) readerOptions() pdf.ReaderOptions {
+	return pdf.ReaderOptions{Password: o.Password, Limits: o.Limits, Cache: o.Cache}
+}
+
+// extractorOptions translates loader options into page extraction options.
+func (o Options) extractorOptions() pdf.ExtractorOptions {
+	return pdf.ExtractorOptions{ExtractImages: o.ExtractImages, UnmappedGlyph: o.UnmappedGlyph}
+}
+
+// logEncrypted reports which password the document is being opened with.
+func logEncrypted(opts Options) {
+	if opts.Password == "" {
+		fmt.Fprintf(os.Stderr, "PDF is encrypted. Attempting to decrypt with empty password (owner-password-only PDFs)...\n")
+	} else {
+		fmt.Fprintf(os.Stderr, "PDF is encrypted. Decrypting with supplied password...\n")
+	}
+}
+
+// readInfo fills in the document information fields of meta. The reader
+// decrypts the Info dictionary, so this works for encrypted documents too.
+func readInfo(reader *pdf.Reader, meta *model.Metadata) {
+	info, err := reader.GetInfo()
+	if err != nil || info == nil {
+		return
+	}
+	if t, ok := info["/Title"].(pdf.StringObject); ok {
+		meta.Title = string(t)
+	}
+	if a, ok := info["/Author"].(pdf.StringObject); ok {
+		meta.Author = string(a)
+	}
+	if c, ok := info["/Creator"].(pdf.StringObject); ok {
+		meta.Creator = string(c)
+	}
+	if p, ok := info["/Producer"].(pdf.StringObject); ok {
+		meta.Producer = string(p)
+	}
+}
+
+// setGeometry fills in the page dimensions and boxes.
+func setGeometry(page *model.Page, g pdf.PageGeometry) {
+	page.Width, page.Height = g.VisualSize()
+	page.MediaBox = g.MediaBox.Array()
+	page.CropBox = g.CropBox.Array()
+	page.Rotation = g.Rotate
+	if g.UserUnit != 1 {
+		page.UserUnit = g.UserUnit
+	}
+}
+
+// appendWarnings adds the warnings not already in dst. Workers each read
+// shared objects such as fonts, so they report the same problems.
+func appendWarnings(dst, src []string) []string {
+	for _, w := range src {
+		dup := false
+		for _, d := range dst {
+			if d == w {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			dst = append(dst, w)
+		}
+	}
+	return dst
+}
+
+// logWarnings reports recovered problems on stderr.
+func logWarnings(warnings []string) {
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+}
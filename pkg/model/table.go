@@ -0,0 +1,79 @@
+package model
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// Table is a table detected on a page.
+type Table struct {
+	BBox    []float64  `json:"bbox"` // [llx, lly, urx, ury] in default user space
+	Columns int        `json:"columns"`
+	Ruled   bool       `json:"ruled"` // Found from ruling lines rather than text alignment
+	Rows    []TableRow `json:"rows"`  // Top to bottom
+}
+
+// TableRow holds the cells that start in a row of a table.
+type TableRow struct {
+	Cells []TableCell `json:"cells"` // Left to right
+}
+
+// TableCell is a cell of a table. A cell spanning several rows or columns
+// appears once, in the row and column where it starts.
+type TableCell struct {
+	Text    string    `json:"text"`
+	BBox    []float64 `json:"bbox"`   // [llx, lly, urx, ury] in default user space
+	Column  int       `json:"column"` // Index of the first column the cell covers
+	RowSpan int       `json:"row_span"`
+	ColSpan int       `json:"col_span"`
+}
+
+// Grid returns the text of the table as rows of columns. A spanning
+// cell's text is in its first position; the others it covers are empty.
+func (t Table) Grid() [][]string {
+	grid := make([][]string, len(t.Rows))
+	for i := range grid {
+		grid[i] = make([]string, t.Columns)
+	}
+	for i, row := range t.Rows {
+		for _, cell := range row.Cells {
+			if cell.Column >= 0 && cell.Column < t.Columns {
+				grid[i][cell.Column] = cell.Text
+			}
+		}
+	}
+	return grid
+}
+
+// Markdown renders the table as a Markdown pipe table. The first row is
+// used as the header.
+func (t Table) Markdown() string {
+	grid := t.Grid()
+	if len(grid) == 0 || t.Columns == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, c := range cells {
+			c = strings.ReplaceAll(c, "|", `\|`)
+			c = strings.Join(strings.Fields(c), " ")
+			sb.WriteString(" " + c + " |")
+		}
+		sb.WriteString("\n")
+	}
+	writeRow(grid[0])
+	sb.WriteString("|" + strings.Repeat(" --- |", t.Columns) + "\n")
+	for _, row := range grid[1:] {
+		writeRow(row)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// CSV renders the table as comma-separated values.
+func (t Table) CSV() string {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	w.WriteAll(t.Grid()) // Writing to a strings.Builder cannot fail
+	return strings.TrimSuffix(sb.String(), "\n")
+}
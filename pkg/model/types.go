@@ -14,24 +14,76 @@ type Metadata struct {
 	Producer string `json:"producer,omitempty"`
 	// Encrypted indicates if the file was password protected
 	Encrypted bool `json:"encrypted"`
+	// Security describes the encryption settings (nil if not encrypted)
+	Security *Security `json:"security,omitempty"`
+	// Repaired indicates the cross-reference table was damaged and rebuilt
+	Repaired bool `json:"repaired,omitempty"`
+	// Warnings lists problems recovered from while reading (bad stream lengths...)
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Security describes how a document is encrypted and what its author permits.
+type Security struct {
+	Revision        int         `json:"revision"`         // Standard security handler revision (2-6)
+	Algorithm       string      `json:"algorithm"`        // "RC4", "AES-128", "AES-256" or "Identity"
+	KeyLength       int         `json:"key_length"`       // Key length in bits
+	EncryptMetadata bool        `json:"encrypt_metadata"` // Whether XMP metadata streams are encrypted
+	OwnerAccess     bool        `json:"owner_access"`     // Opened with the owner password
+	Permissions     Permissions `json:"permissions"`
+}
+
+// Permissions holds the decoded /P flags of an encrypted document.
+type Permissions struct {
+	Print            bool `json:"print"`
+	Modify           bool `json:"modify"`
+	CopyExtract      bool `json:"copy_extract"`
+	Annotate         bool `json:"annotate"`
+	FillForms        bool `json:"fill_forms"`
+	Accessibility    bool `json:"accessibility"` // Extract for accessibility
+	Assemble         bool `json:"assemble"`
+	PrintHighQuality bool `json:"print_high_quality"`
 }
 
 // Page represents a single page in the PDF.
 type Page struct {
-	PageNumber int      `json:"page_number"`
-	Content    string   `json:"content"` // Markdown/Formatted text
-	CharCount  int      `json:"char_count"`
-	Width      float64  `json:"width"`
-	Height     float64  `json:"height"`
-	Images     *[]Image `json:"images,omitempty"` // Pointer allows nil (omitted) vs empty slice (shown as [])
+	PageNumber int       `json:"page_number"`
+	Content    string    `json:"content"` // Markdown/Formatted text
+	CharCount  int       `json:"char_count"`
+	Width      float64   `json:"width"`               // Visual width: crop box, rotated and scaled by UserUnit
+	Height     float64   `json:"height"`              // Visual height: crop box, rotated and scaled by UserUnit
+	MediaBox   []float64 `json:"mediabox,omitempty"`  // [llx, lly, urx, ury] in default user space
+	CropBox    []float64 `json:"cropbox,omitempty"`   // [llx, lly, urx, ury], clipped to the MediaBox
+	Rotation   int       `json:"rotation"`            // Clockwise display rotation: 0, 90, 180 or 270
+	UserUnit   float64   `json:"user_unit,omitempty"` // Omitted when 1 (1/72 inch)
+	Images     *[]Image  `json:"images,omitempty"`    // Pointer allows nil (omitted) vs empty slice (shown as [])
+
+	// Text segmentation, filled in when requested
+	Words  []TextSpan `json:"words,omitempty"`
+	Lines  []TextSpan `json:"lines,omitempty"`
+	Blocks []TextSpan `json:"blocks,omitempty"` // Paragraph-like groups of lines
+
+	// ReadingOrderConfidence rates, from 0 to 1, how sure the reading order
+	// layout mode is of the order of the blocks. Nil in other modes.
+	ReadingOrderConfidence *float64 `json:"reading_order_confidence,omitempty"`
+
+	// Tables detected on the page, filled in when requested
+	Tables []Table `json:"tables,omitempty"`
+}
+
+// TextSpan is a word, line or block of text on a page.
+type TextSpan struct {
+	Text     string    `json:"text"`                // Lines of a block are separated by "\n"
+	BBox     []float64 `json:"bbox"`                // [llx, lly, urx, ury] in default user space
+	Font     string    `json:"font,omitempty"`      // Dominant font, by character count
+	FontSize float64   `json:"font_size,omitempty"` // Size of the dominant font on the page
 }
 
 // Image represents an image reference on a page.
 type Image struct {
-	Type       string    `json:"type"`                // "image" or "inline_image"
-	ID         string    `json:"id,omitempty"`        // e.g., "Im1" (empty for inline images)
-	Rect       []float64 `json:"rect,omitempty"`      // [x, y, width, height]
-	Width      float64   `json:"width,omitempty"`     // Image width in pixels
-	Height     float64   `json:"height,omitempty"`    // Image height in pixels
+	Type       string    `json:"type"`                  // "image" or "inline_image"
+	ID         string    `json:"id,omitempty"`          // e.g., "Im1" (empty for inline images)
+	Rect       []float64 `json:"rect,omitempty"`        // [x, y, width, height]
+	Width      float64   `json:"width,omitempty"`       // Image width in pixels
+	Height     float64   `json:"height,omitempty"`      // Image height in pixels
 	ColorSpace string    `json:"color_space,omitempty"` // e.g., "/DeviceRGB"
 }
@@ -0,0 +1,262 @@
+package pdf
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Object cache.
+//
+// Resolved objects are kept in a least-recently-used cache bounded by entry
+// count and by an estimate of their size, so that a Reader kept open on a
+// large document does not grow without bound. Objects are keyed by number
+// and generation, as incremental updates may reuse an object number. The
+// same cache holds what is built from objects: parsed object streams and
+// fonts.
+
+// Default cache bounds, used for the zero values in CacheOptions
+const (
+	DefaultCacheEntries = 100000
+	DefaultCacheBytes   = 256 << 20
+)
+
+// CacheOptions bounds the object cache of a Reader. Zero selects the
+// Default* value and a negative value disables the bound.
+type CacheOptions struct {
+	MaxEntries int   // Cached objects
+	MaxBytes   int64 // Estimated size of the cached objects, decoded stream data included
+
+	// ExcludeStreams keeps decoded stream data out of the cache. Stream
+	// dictionaries are still cached, but every lookup returns a stream
+	// that decodes its data again.
+	ExcludeStreams bool
+}
+
+// withDefaults replaces zero bounds with the defaults
+func (o CacheOptions) withDefaults() CacheOptions {
+	if o.MaxEntries == 0 {
+		o.MaxEntries = DefaultCacheEntries
+	}
+	if o.MaxBytes == 0 {
+		o.MaxBytes = DefaultCacheBytes
+	}
+	return o
+}
+
+// CacheStats reports the activity of a Reader's object cache.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Entries   int   // Objects, object streams and fonts currently cached
+	Bytes     int64 // Estimated size of the entries currently cached
+}
+
+// cacheKind tells apart the values cached for one object
+type cacheKind uint8
+
+const (
+	kindObject cacheKind = iota // The resolved object
+	kindObjStm                  // *objectStream parsed from an object stream
+	kindFont                    // *Font built from a font dictionary
+)
+
+// objKey identifies an indirect object, or a value built from it
+type objKey struct {
+	num, gen int
+	kind     cacheKind
+}
+
+// cacheEntry is an element of the LRU list
+type cacheEntry struct {
+	key   objKey
+	obj   Object // For kindObject
+	value any    // For the other kinds
+	size  int64
+}
+
+// objectCache is a thread-safe LRU cache of resolved objects
+type objectCache struct {
+	mu    sync.Mutex
+	opts  CacheOptions
+	lru   *list.List // Most recently used at the front
+	items map[objKey]*list.Element
+	stats CacheStats
+}
+
+func newObjectCache(opts CacheOptions) *objectCache {
+	return &objectCache{
+		opts:  opts.withDefaults(),
+		lru:   list.New(),
+		items: make(map[objKey]*list.Element),
+	}
+}
+
+// get returns a cached object and marks it as recently used
+func (c *objectCache) get(key objKey) (Object, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(el)
+	obj := el.Value.(*cacheEntry).obj
+	if s, ok := obj.(StreamObject); ok && c.opts.ExcludeStreams {
+		return s.detached(), true
+	}
+	return obj, true
+}
+
+// add caches a newly read object and returns the object to hand out in its
+// place. Streams are cached before their data is loaded; the size of the
+// data is added to their entry once it is.
+func (c *objectCache) add(key objKey, obj Object) Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := obj
+	if s, ok := obj.(StreamObject); ok && s.body != nil {
+		if c.opts.ExcludeStreams {
+			// The cached stream is never loaded; callers get copies
+			out = s.detached()
+		} else if !s.body.loaded {
+			body := s.body
+			body.onLoad = func(n int) { c.grow(key, body, int64(n)) }
+		}
+	}
+
+	c.insert(&cacheEntry{key: key, obj: obj, size: objectSize(obj)})
+	return out
+}
+
+// getValue returns a value other than an object, e.g. a parsed object stream
+func (c *objectCache) getValue(key objKey) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(el)
+	return el.Value.(*cacheEntry).value, true
+}
+
+// addValue caches a value of the given estimated size
+func (c *objectCache) addValue(key objKey, value any, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.insert(&cacheEntry{key: key, value: value, size: size})
+}
+
+// insert adds an entry, replacing any with the same key
+func (c *objectCache) insert(e *cacheEntry) {
+	if el, ok := c.items[e.key]; ok {
+		c.remove(el)
+	}
+	c.items[e.key] = c.lru.PushFront(e)
+	c.stats.Entries++
+	c.stats.Bytes += e.size
+	c.evict()
+}
+
+// grow adds the size of a stream's data once it has been loaded
+func (c *objectCache) grow(key objKey, body *streamBody, n int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return
+	}
+	e := el.Value.(*cacheEntry)
+	if s, ok := e.obj.(StreamObject); !ok || s.body != body {
+		return // Replaced since
+	}
+	e.size += n
+	c.stats.Bytes += n
+	c.evict()
+}
+
+// evict drops the least recently used objects until the cache is within bounds
+func (c *objectCache) evict() {
+	for c.lru.Len() > 0 &&
+		((c.opts.MaxEntries >= 0 && c.stats.Entries > c.opts.MaxEntries) ||
+			(c.opts.MaxBytes >= 0 && c.stats.Bytes > c.opts.MaxBytes)) {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *objectCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*cacheEntry)
+	delete(c.items, e.key)
+	c.stats.Entries--
+	c.stats.Bytes -= e.size
+}
+
+func (c *objectCache) snapshot() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// CacheStats returns the hits, misses, evictions and current size of the
+// object cache.
+func (r *Reader) CacheStats() CacheStats {
+	return r.cache.snapshot()
+}
+
+// objectSize estimates the memory held by an object. Only the dictionary of
+// a stream is counted; its data is added when loaded.
+func objectSize(obj Object) int64 {
+	const overhead = 16 // Interface value
+	switch o := obj.(type) {
+	case StringObject:
+		return overhead + int64(len(o))
+	case HexStringObject:
+		return overhead + int64(len(o))
+	case NameObject:
+		return overhead + int64(len(o))
+	case KeywordObject:
+		return overhead + int64(len(o))
+	case ArrayObject:
+		size := int64(overhead + 24)
+		for _, v := range o {
+			size += objectSize(v)
+		}
+		return size
+	case DictionaryObject:
+		size := int64(overhead + 48)
+		for k, v := range o {
+			size += 16 + int64(len(k)) + objectSize(v)
+		}
+		return size
+	case StreamObject:
+		size := objectSize(o.Dictionary) + 64
+		if o.body != nil && o.body.loaded {
+			size += int64(len(o.body.data))
+		}
+		return size
+	default:
+		return overhead + 16
+	}
+}
+
+// fontSize estimates the memory held by a font, mostly its maps
+func fontSize(f *Font) int64 {
+	const mapEntry = 48
+	size := int64(512)
+	size += mapEntry * int64(len(f.Encoding)+len(f.Widths)+len(f.VMetrics))
+	size += 2 * int64(len(f.cidToGID))
+	if f.CMap != nil {
+		size += mapEntry*int64(len(f.CMap.bf)+len(f.CMap.cidChars)) + 24*int64(len(f.CMap.cidRanges))
+	}
+	if f.program != nil {
+		size += mapEntry * int64(len(f.program.glyphNames)+len(f.program.gidUnicode))
+	}
+	return size
+}
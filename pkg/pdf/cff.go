@@ -0,0 +1,362 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Compact Font Format programs (/FontFile3 /Type1C, Adobe TN #5176).
+// Only the charset and encoding are read, which give glyph names per GID
+// and the font's built-in encoding.
+
+var errBadCFF = errors.New("pdf: malformed CFF font program")
+
+// Top DICT operators
+const (
+	cffOpCharset     = 15
+	cffOpEncoding    = 16
+	cffOpCharStrings = 17
+	cffOpROS         = 12<<8 | 30
+)
+
+// cffIndex reads an INDEX structure at off, returning its items and the offset after it
+func cffIndex(data []byte, off int) ([][]byte, int, error) {
+	if off+2 > len(data) {
+		return nil, 0, errBadCFF
+	}
+	count := int(binary.BigEndian.Uint16(data[off:]))
+	if count == 0 {
+		return nil, off + 2, nil
+	}
+	if off+3 > len(data) {
+		return nil, 0, errBadCFF
+	}
+	offSize := int(data[off+2])
+	if offSize < 1 || offSize > 4 {
+		return nil, 0, errBadCFF
+	}
+	offsets := off + 3
+	base := offsets + (count+1)*offSize - 1 // Offsets are 1-based
+	if base >= len(data) {
+		return nil, 0, errBadCFF
+	}
+
+	readOff := func(i int) int {
+		v := 0
+		for _, b := range data[offsets+i*offSize : offsets+(i+1)*offSize] {
+			v = v<<8 | int(b)
+		}
+		return base + v
+	}
+
+	items := make([][]byte, count)
+	for i := range items {
+		start, end := readOff(i), readOff(i+1)
+		if start > end || end > len(data) {
+			return nil, 0, errBadCFF
+		}
+		items[i] = data[start:end]
+	}
+	return items, readOff(count), nil
+}
+
+// cffDict parses a DICT into operator -> operands
+func cffDict(data []byte) map[int][]float64 {
+	dict := make(map[int][]float64)
+	var operands []float64
+	for i := 0; i < len(data); {
+		b0 := int(data[i])
+		switch {
+		case b0 <= 21: // Operator
+			op := b0
+			i++
+			if b0 == 12 && i < len(data) {
+				op = 12<<8 | int(data[i])
+				i++
+			}
+			dict[op] = operands
+			operands = nil
+		case b0 == 28 && i+2 < len(data):
+			operands = append(operands, float64(int16(binary.BigEndian.Uint16(data[i+1:]))))
+			i += 3
+		case b0 == 29 && i+4 < len(data):
+			operands = append(operands, float64(int32(binary.BigEndian.Uint32(data[i+1:]))))
+			i += 5
+		case b0 == 30: // Real number: nibbles until 0xF; the value is not needed here
+			i++
+			for i < len(data) {
+				b := data[i]
+				i++
+				if b&0x0F == 0x0F || b>>4 == 0x0F {
+					break
+				}
+			}
+			operands = append(operands, 0)
+		case b0 >= 32 && b0 <= 246:
+			operands = append(operands, float64(b0-139))
+			i++
+		case b0 >= 247 && b0 <= 250 && i+1 < len(data):
+			operands = append(operands, float64((b0-247)*256+int(data[i+1])+108))
+			i += 2
+		case b0 >= 251 && b0 <= 254 && i+1 < len(data):
+			operands = append(operands, float64(-(b0-251)*256-int(data[i+1])-108))
+			i += 2
+		default:
+			return dict
+		}
+	}
+	return dict
+}
+
+// parseCFF reads glyph names and the built-in encoding of the first font in a CFF program
+func parseCFF(data []byte) (*fontProgram, error) {
+	if len(data) < 4 {
+		return nil, errBadCFF
+	}
+	_, off, err := cffIndex(data, int(data[2])) // Name INDEX
+	if err != nil {
+		return nil, err
+	}
+	topDicts, off, err := cffIndex(data, off)
+	if err != nil || len(topDicts) == 0 {
+		return nil, errBadCFF
+	}
+	strs, _, err := cffIndex(data, off)
+	if err != nil {
+		return nil, err
+	}
+
+	top := cffDict(topDicts[0])
+	operand := func(op, def int) int {
+		if v := top[op]; len(v) > 0 {
+			return int(v[0])
+		}
+		return def
+	}
+
+	// Glyph count comes from the CharStrings INDEX
+	csOff := operand(cffOpCharStrings, 0)
+	if csOff <= 0 || csOff >= len(data) {
+		return nil, errBadCFF
+	}
+	if len(data) < csOff+2 {
+		return nil, errBadCFF
+	}
+	numGlyphs := int(binary.BigEndian.Uint16(data[csOff:]))
+
+	prog := &fontProgram{glyphNames: make(map[int]string)}
+	if _, cidKeyed := top[cffOpROS]; cidKeyed {
+		// CID-keyed fonts map GIDs to CIDs, not names
+		return prog, nil
+	}
+
+	sidName := func(sid int) string {
+		if sid < len(cffStandardStrings) {
+			return "/" + cffStandardStrings[sid]
+		}
+		if sid-len(cffStandardStrings) < len(strs) {
+			return "/" + string(strs[sid-len(cffStandardStrings)])
+		}
+		return ""
+	}
+
+	// Charset: GID -> SID
+	sids := cffCharset(data, operand(cffOpCharset, 0), numGlyphs)
+	nameToGID := make(map[string]int, len(sids))
+	for gid, sid := range sids {
+		if name := sidName(sid); name != "" {
+			prog.glyphNames[gid] = name
+			nameToGID[name] = gid
+		}
+	}
+
+	// Encoding: code -> GID, or a predefined encoding
+	switch encOff := operand(cffOpEncoding, 0); encOff {
+	case 0:
+		enc := standardEncoding
+		prog.encoding = &enc
+	case 1:
+		// Expert encoding: rare, glyph names alone are used
+	default:
+		prog.encoding = cffEncoding(data, encOff, sids, sidName)
+	}
+	return prog, nil
+}
+
+// cffCharset returns the SID of every glyph
+func cffCharset(data []byte, off, numGlyphs int) []int {
+	sids := make([]int, numGlyphs)
+	if off == 0 {
+		// ISOAdobe charset: SID = GID
+		for gid := range sids {
+			if gid < 229 {
+				sids[gid] = gid
+			}
+		}
+		return sids
+	}
+	if off <= 2 || off >= len(data) {
+		// Expert charsets: no usable names
+		return sids
+	}
+
+	u16 := func(p int) int {
+		if p+2 > len(data) {
+			return -1
+		}
+		return int(binary.BigEndian.Uint16(data[p:]))
+	}
+
+	format := data[off]
+	p := off + 1
+	gid := 1
+	switch format {
+	case 0:
+		for ; gid < numGlyphs; gid++ {
+			sid := u16(p)
+			if sid < 0 {
+				break
+			}
+			sids[gid] = sid
+			p += 2
+		}
+	case 1, 2:
+		for gid < numGlyphs {
+			first := u16(p)
+			if first < 0 {
+				break
+			}
+			var nLeft int
+			if format == 1 {
+				if p+2 >= len(data) {
+					break
+				}
+				nLeft = int(data[p+2])
+				p += 3
+			} else {
+				nLeft = u16(p + 2)
+				if nLeft < 0 {
+					break
+				}
+				p += 4
+			}
+			for i := 0; i <= nLeft && gid < numGlyphs; i++ {
+				sids[gid] = first + i
+				gid++
+			}
+		}
+	}
+	return sids
+}
+
+// cffEncoding builds the built-in encoding from a custom Encoding table
+func cffEncoding(data []byte, off int, sids []int, sidName func(int) string) *[256]string {
+	if off >= len(data) {
+		return nil
+	}
+	var enc [256]string
+	format := data[off]
+	p := off + 1
+	name := func(gid int) string {
+		if gid < len(sids) {
+			return sidName(sids[gid])
+		}
+		return ""
+	}
+
+	switch format & 0x7F {
+	case 0:
+		if p >= len(data) {
+			return nil
+		}
+		nCodes := int(data[p])
+		p++
+		for i := 0; i < nCodes && p < len(data); i++ {
+			enc[data[p]] = name(i + 1)
+			p++
+		}
+	case 1:
+		if p >= len(data) {
+			return nil
+		}
+		nRanges := int(data[p])
+		p++
+		gid := 1
+		for i := 0; i < nRanges && p+1 < len(data); i++ {
+			first, nLeft := int(data[p]), int(data[p+1])
+			p += 2
+			for c := first; c <= first+nLeft && c < 256; c++ {
+				enc[c] = name(gid)
+				gid++
+			}
+		}
+	default:
+		return nil
+	}
+
+	// Supplements map extra codes to glyphs by SID
+	if format&0x80 != 0 && p < len(data) {
+		nSups := int(data[p])
+		p++
+		for i := 0; i < nSups && p+2 < len(data); i++ {
+			code := data[p]
+			sid := int(binary.BigEndian.Uint16(data[p+1:]))
+			enc[code] = sidName(sid)
+			p += 3
+		}
+	}
+	return &enc
+}
+
+// cffStandardStrings are the predefined SIDs 0-390 (TN #5176 Appendix A)
+var cffStandardStrings = [391]string{
+	".notdef", "space", "exclam", "quotedbl", "numbersign", "dollar", "percent", "ampersand",
+	"quoteright", "parenleft", "parenright", "asterisk", "plus", "comma", "hyphen", "period",
+	"slash", "zero", "one", "two", "three", "four", "five", "six",
+	"seven", "eight", "nine", "colon", "semicolon", "less", "equal", "greater",
+	"question", "at", "A", "B", "C", "D", "E", "F",
+	"G", "H", "I", "J", "K", "L", "M", "N",
+	"O", "P", "Q", "R", "S", "T", "U", "V",
+	"W", "X", "Y", "Z", "bracketleft", "backslash", "bracketright", "asciicircum",
+	"underscore", "quoteleft", "a", "b", "c", "d", "e", "f",
+	"g", "h", "i", "j", "k", "l", "m", "n",
+	"o", "p", "q", "r", "s", "t", "u", "v",
+	"w", "x", "y", "z", "braceleft", "bar", "braceright", "asciitilde",
+	"exclamdown", "cent", "sterling", "fraction", "yen", "florin", "section", "currency",
+	"quotesingle", "quotedblleft", "guillemotleft", "guilsinglleft", "guilsinglright", "fi", "fl", "endash",
+	"dagger", "daggerdbl", "periodcentered", "paragraph", "bullet", "quotesinglbase", "quotedblbase", "quotedblright",
+	"guillemotright", "ellipsis", "perthousand", "questiondown", "grave", "acute", "circumflex", "tilde",
+	"macron", "breve", "dotaccent", "dieresis", "ring", "cedilla", "hungarumlaut", "ogonek",
+	"caron", "emdash", "AE", "ordfeminine", "Lslash", "Oslash", "OE", "ordmasculine",
+	"ae", "dotlessi", "lslash", "oslash", "oe", "germandbls", "onesuperior", "logicalnot",
+	"mu", "trademark", "Eth", "onehalf", "plusminus", "Thorn", "onequarter", "divide",
+	"brokenbar", "degree", "thorn", "threequarters", "twosuperior", "registered", "minus", "eth",
+	"multiply", "threesuperior", "copyright", "Aacute", "Acircumflex", "Adieresis", "Agrave", "Aring",
+	"Atilde", "Ccedilla", "Eacute", "Ecircumflex", "Edieresis", "Egrave", "Iacute", "Icircumflex",
+	"Idieresis", "Igrave", "Ntilde", "Oacute", "Ocircumflex", "Odieresis", "Ograve", "Otilde",
+	"Scaron", "Uacute", "Ucircumflex", "Udieresis", "Ugrave", "Yacute", "Ydieresis", "Zcaron",
+	"aacute", "acircumflex", "adieresis", "agrave", "aring", "atilde", "ccedilla", "eacute",
+	"ecircumflex", "edieresis", "egrave", "iacute", "icircumflex", "idieresis", "igrave", "ntilde",
+	"oacute", "ocircumflex", "odieresis", "ograve", "otilde", "scaron", "uacute", "ucircumflex",
+	"udieresis", "ugrave", "yacute", "ydieresis", "zcaron", "exclamsmall", "Hungarumlautsmall", "dollaroldstyle",
+	"dollarsuperior", "ampersandsmall", "Acutesmall", "parenleftsuperior", "parenrightsuperior", "twodotenleader", "onedotenleader", "zerooldstyle",
+	"oneoldstyle", "twooldstyle", "threeoldstyle", "fouroldstyle", "fiveoldstyle", "sixoldstyle", "sevenoldstyle", "eightoldstyle",
+	"nineoldstyle", "commasuperior", "threequartersemdash", "periodsuperior", "questionsmall", "asuperior", "bsuperior", "centsuperior",
+	"dsuperior", "esuperior", "isuperior", "lsuperior", "msuperior", "nsuperior", "osuperior", "rsuperior",
+	"ssuperior", "tsuperior", "ff", "ffi", "ffl", "parenleftinferior", "parenrightinferior", "Circumflexsmall",
+	"hyphensuperior", "Gravesmall", "Asmall", "Bsmall", "Csmall", "Dsmall", "Esmall", "Fsmall",
+	"Gsmall", "Hsmall", "Ismall", "Jsmall", "Ksmall", "Lsmall", "Msmall", "Nsmall",
+	"Osmall", "Psmall", "Qsmall", "Rsmall", "Ssmall", "Tsmall", "Usmall", "Vsmall",
+	"Wsmall", "Xsmall", "Ysmall", "Zsmall", "colonmonetary", "onefitted", "rupiah", "Tildesmall",
+	"exclamdownsmall", "centoldstyle", "Lslashsmall", "Scaronsmall", "Zcaronsmall", "Dieresissmall", "Brevesmall", "Caronsmall",
+	"Dotaccentsmall", "Macronsmall", "figuredash", "hypheninferior", "Ogoneksmall", "Ringsmall", "Cedillasmall", "questiondownsmall",
+	"oneeighth", "threeeighths", "fiveeighths", "seveneighths", "onethird", "twothirds", "zerosuperior", "foursuperior",
+	"fivesuperior", "sixsuperior", "sevensuperior", "eightsuperior", "ninesuperior", "zeroinferior", "oneinferior", "twoinferior",
+	"threeinferior", "fourinferior", "fiveinferior", "sixinferior", "seveninferior", "eightinferior", "nineinferior", "centinferior",
+	"dollarinferior", "periodinferior", "commainferior", "Agravesmall", "Aacutesmall", "Acircumflexsmall", "Atildesmall", "Adieresissmall",
+	"Aringsmall", "AEsmall", "Ccedillasmall", "Egravesmall", "Eacutesmall", "Ecircumflexsmall", "Edieresissmall", "Igravesmall",
+	"Iacutesmall", "Icircumflexsmall", "Idieresissmall", "Ethsmall", "Ntildesmall", "Ogravesmall", "Oacutesmall", "Ocircumflexsmall",
+	"Otildesmall", "Odieresissmall", "OEsmall", "Oslashsmall", "Ugravesmall", "Uacutesmall", "Ucircumflexsmall", "Udieresissmall",
+	"Yacutesmall", "Thornsmall", "Ydieresissmall", "001.000", "001.001", "001.002", "001.003", "Black",
+	"Bold", "Book", "Light", "Medium", "Regular", "Roman", "Semibold",
+}
@@ -0,0 +1,372 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"embed"
+	"encoding/binary"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Predefined CJK CMaps (PDF 32000-1 9.7.5.2).
+//
+// A Type0 font may name one of Adobe's predefined CMaps as its /Encoding
+// instead of embedding one. The CMap's code -> CID table is embedded in
+// cmapdata/ and gives the CID for /W metrics; without a /ToUnicode map the
+// CID is turned into text through the CID -> Unicode table of its character
+// collection (Adobe-Japan1-UCS2, ...). The Uni* CMaps are keyed by Unicode
+// and decode directly. Codes of the legacy CMaps (Shift-JIS, EUC, GBK, Big
+// Five, UHC) that have no CID fall back to their national encoding. All
+// tables are compressed and decompressed on first use (see cmapdata/gen.py
+// for the format).
+
+//go:embed cmapdata/*.z
+var cmapData embed.FS
+
+// codeTable is a code -> Unicode table for a legacy CJK encoding, or the
+// CID -> Unicode table of a character collection. It is decompressed on
+// first use.
+type codeTable struct {
+	file string
+	once sync.Once
+	runs []codeRun
+}
+
+// codeRun maps n consecutive codes starting at code to consecutive code points starting at uni
+type codeRun struct {
+	code uint32
+	uni  rune
+	n    uint32
+}
+
+var (
+	tableCP932     = &codeTable{file: "cp932"}
+	tableEUCJP     = &codeTable{file: "eucjp"}
+	tableGB18030   = &codeTable{file: "gb18030"}
+	tableCP950     = &codeTable{file: "cp950"}
+	tableBig5HKSCS = &codeTable{file: "big5hkscs"}
+	tableCP949     = &codeTable{file: "cp949"}
+)
+
+func (t *codeTable) load() {
+	t.once.Do(func() {
+		readRuns(t.file, func(code uint64, value int64, n uint64) {
+			t.runs = append(t.runs, codeRun{code: uint32(code), uni: rune(value), n: uint32(n)})
+		})
+	})
+}
+
+// readRuns calls fn for each run of a compressed table in cmapdata/.
+// A missing or damaged table yields no runs.
+func readRuns(file string, fn func(code uint64, value int64, n uint64)) {
+	raw, err := cmapData.ReadFile("cmapdata/" + file + ".z")
+	if err != nil {
+		return
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return
+	}
+
+	var code uint64
+	var value int64
+	for len(data) > 0 {
+		dc, n1 := binary.Uvarint(data)
+		if n1 <= 0 {
+			return
+		}
+		dv, n2 := binary.Varint(data[n1:])
+		if n2 <= 0 {
+			return
+		}
+		count, n3 := binary.Uvarint(data[n1+n2:])
+		if n3 <= 0 {
+			return
+		}
+		data = data[n1+n2+n3:]
+
+		code += dc
+		value += dv
+		fn(code, value, count)
+		code += count
+		value += int64(count)
+	}
+}
+
+// lookup returns the code point for a code
+func (t *codeTable) lookup(code uint32) (rune, bool) {
+	t.load()
+	i := sort.Search(len(t.runs), func(i int) bool {
+		return t.runs[i].code+t.runs[i].n > code
+	})
+	if i == len(t.runs) || t.runs[i].code > code {
+		return 0, false
+	}
+	r := t.runs[i]
+	return r.uni + rune(code-r.code), true
+}
+
+// cidCMap is the code -> CID table of a predefined CMap, built into a CMap
+// on first use. A -V table only holds its vertical forms and falls back to
+// the -H table.
+type cidCMap struct {
+	file       string
+	parent     *cidCMap
+	codespaces *CMap
+	once       sync.Once
+	cmap       *CMap
+}
+
+func (t *cidCMap) load() *CMap {
+	t.once.Do(func() {
+		t.cmap = NewCMap()
+		if t.codespaces != nil {
+			t.cmap.Codespaces = t.codespaces.Codespaces
+		}
+		if t.parent != nil {
+			t.cmap.Parent = t.parent.load()
+		}
+		readRuns(t.file, func(code uint64, cid int64, n uint64) {
+			t.cmap.cidRanges = append(t.cmap.cidRanges, cidRange{lo: code, hi: code + n - 1, cid: int(cid)})
+		})
+	})
+	return t.cmap
+}
+
+// unicodeForm is how a predefined CMap's codes relate to Unicode
+type unicodeForm int
+
+const (
+	formLegacy unicodeForm = iota // National encoding, decoded with a codeTable
+	formUCS2
+	formUTF16
+	formUTF8
+	formUTF32
+)
+
+// predefinedCMap describes one family of predefined CMaps (the -H and -V variants)
+type predefinedCMap struct {
+	ordering   string // Registry-Ordering of the character collection
+	form       unicodeForm
+	table      *codeTable
+	offset     uint32 // Added to 2-byte codes before lookup (ISO-2022 row/cell -> EUC)
+	codespaces *CMap
+	h, v       *cidCMap // Code -> CID; the Uni* forms share their family's UTF-32 table
+}
+
+func codespaceCMap(ranges ...string) *CMap {
+	c := NewCMap()
+	for i := 0; i+1 < len(ranges); i += 2 {
+		c.Codespaces = append(c.Codespaces, CodespaceRange{Low: []byte(ranges[i]), High: []byte(ranges[i+1])})
+	}
+	return c
+}
+
+var (
+	csShiftJIS = codespaceCMap("\x00", "\x80", "\xA0", "\xDF", "\x81\x40", "\x9F\xFC", "\xE0\x40", "\xFC\xFC")
+	csEUCJP    = codespaceCMap("\x00", "\x80", "\x8E\xA0", "\x8E\xDF", "\xA1\xA1", "\xFE\xFE", "\x8F\xA1\xA1", "\x8F\xFE\xFE")
+	csISO2022  = codespaceCMap("\x21\x21", "\x7E\x7E")
+	csGB18030  = codespaceCMap("\x00", "\x80", "\x81\x40", "\xFE\xFE", "\x81\x30\x81\x30", "\xFE\x39\xFE\x39")
+	csBig5     = codespaceCMap("\x00", "\x80", "\x81\x40", "\xFE\xFE")
+	csUHC      = codespaceCMap("\x00", "\x80", "\x81\x41", "\xFE\xFE")
+)
+
+// predefinedCMaps is keyed by CMap name without the -H/-V suffix
+var predefinedCMaps = map[string]*predefinedCMap{}
+
+// newCIDCMaps returns the -H and -V code -> CID tables of a CMap family
+func newCIDCMaps(name string, cs *CMap) (h, v *cidCMap) {
+	hName, vName := "H", "V"
+	if name != "" {
+		hName, vName = name+"-H", name+"-V"
+	}
+	h = &cidCMap{file: hName, codespaces: cs}
+	return h, &cidCMap{file: vName, parent: h, codespaces: cs}
+}
+
+func init() {
+	legacy := func(ordering string, table *codeTable, cs *CMap, offset uint32, names ...string) {
+		for _, name := range names {
+			p := &predefinedCMap{ordering: ordering, form: formLegacy, table: table, offset: offset, codespaces: cs}
+			p.h, p.v = newCIDCMaps(name, cs)
+			predefinedCMaps[name] = p
+		}
+	}
+	uni := func(ordering, name string, form unicodeForm, family string) {
+		p := &predefinedCMap{ordering: ordering, form: form}
+		p.h, p.v = newCIDCMaps(family+"-UTF32", nil)
+		predefinedCMaps[name] = p
+	}
+	unicode := func(ordering, prefix string) {
+		uni(ordering, prefix+"-UCS2", formUCS2, prefix)
+		uni(ordering, prefix+"-UTF16", formUTF16, prefix)
+		uni(ordering, prefix+"-UTF8", formUTF8, prefix)
+		uni(ordering, prefix+"-UTF32", formUTF32, prefix)
+	}
+
+	// Japanese
+	legacy("Adobe-Japan1", tableCP932, csShiftJIS, 0,
+		"83pv-RKSJ", "90ms-RKSJ", "90msp-RKSJ", "90pv-RKSJ", "78-RKSJ", "78ms-RKSJ", "Add-RKSJ", "Ext-RKSJ")
+	legacy("Adobe-Japan1", tableEUCJP, csEUCJP, 0, "EUC", "78-EUC")
+	legacy("Adobe-Japan1", tableEUCJP, csISO2022, 0x8080, "", "78", "Add", "Ext", "NWP")
+	unicode("Adobe-Japan1", "UniJIS")
+	unicode("Adobe-Japan1", "UniJIS2004")
+	uni("Adobe-Japan1", "UniJIS-UCS2-HW", formUCS2, "UniJIS")
+	uni("Adobe-Japan1", "UniJISPro-UCS2", formUCS2, "UniJIS")
+	uni("Adobe-Japan1", "UniJISPro-UCS2-HW", formUCS2, "UniJIS")
+	uni("Adobe-Japan1", "UniJISPro-UTF8", formUTF8, "UniJIS")
+	uni("Adobe-Japan1", "UniJISX0213-UTF32", formUTF32, "UniJISX0213")
+	uni("Adobe-Japan1", "UniJISX02132004-UTF32", formUTF32, "UniJISX02132004")
+
+	// Simplified Chinese (GBK and GB 2312 are subsets of GB 18030)
+	legacy("Adobe-GB1", tableGB18030, csGB18030, 0, "GB-EUC", "GBpc-EUC", "GBK-EUC", "GBKp-EUC", "GBK2K")
+	legacy("Adobe-GB1", tableGB18030, csISO2022, 0x8080, "GB")
+	unicode("Adobe-GB1", "UniGB")
+
+	// Traditional Chinese
+	legacy("Adobe-CNS1", tableCP950, csBig5, 0, "B5", "B5pc", "ETen-B5", "ETenms-B5")
+	legacy("Adobe-CNS1", tableBig5HKSCS, csBig5, 0, "HKscs-B5")
+	unicode("Adobe-CNS1", "UniCNS")
+
+	// Korean
+	legacy("Adobe-Korea1", tableCP949, csUHC, 0, "KSC-EUC", "KSCpc-EUC", "KSCms-UHC", "KSCms-UHC-HW")
+	legacy("Adobe-Korea1", tableCP949, csISO2022, 0x8080, "KSC")
+	unicode("Adobe-Korea1", "UniKS")
+}
+
+// lookupPredefinedCMap finds a predefined CMap by name (e.g. "/90ms-RKSJ-H").
+// It reports whether the name selects vertical writing mode.
+func lookupPredefinedCMap(name string) (*predefinedCMap, bool) {
+	name = strings.TrimPrefix(name, "/")
+	vertical := name == "V" || strings.HasSuffix(name, "-V")
+
+	base := name
+	switch {
+	case name == "H" || name == "V":
+		base = ""
+	case strings.HasSuffix(name, "-H") || strings.HasSuffix(name, "-V"):
+		base = name[:len(name)-2]
+	}
+	return predefinedCMaps[base], vertical
+}
+
+// codeLength returns the byte length of the character code at the start of data
+func (p *predefinedCMap) codeLength(data []byte) int {
+	n := 2
+	switch p.form {
+	case formLegacy:
+		return p.codespaces.codeLength(data)
+	case formUTF16:
+		// High surrogate starts a 4-byte pair
+		if len(data) >= 2 && data[0] >= 0xD8 && data[0] <= 0xDB {
+			n = 4
+		}
+	case formUTF8:
+		switch b := data[0]; {
+		case b < 0x80:
+			n = 1
+		case b >= 0xF0:
+			n = 4
+		case b >= 0xE0:
+			n = 3
+		}
+	case formUTF32:
+		n = 4
+	}
+	return min(n, len(data))
+}
+
+// encoding returns the code -> CID CMap for a writing mode
+func (p *predefinedCMap) encoding(vertical bool) *CMap {
+	if vertical {
+		return p.v.load()
+	}
+	return p.h.load()
+}
+
+// cid returns the CID selected by a character code
+func (p *predefinedCMap) cid(code []byte, vertical bool) (int, bool) {
+	if p.form == formLegacy {
+		return p.encoding(vertical).CID(code)
+	}
+	// Uni* codes are looked up by code point in the UTF-32 table
+	text := p.toUnicode(code)
+	r, size := utf8.DecodeRuneInString(text)
+	if size == 0 || size != len(text) {
+		return 0, false
+	}
+	var key [4]byte
+	binary.BigEndian.PutUint32(key[:], uint32(r))
+	return p.encoding(vertical).CID(key[:])
+}
+
+// toUnicode decodes a character code to text
+func (p *predefinedCMap) toUnicode(code []byte) string {
+	switch p.form {
+	case formUCS2, formUTF16:
+		return decodeUTF16BE(code)
+	case formUTF8:
+		if utf8.Valid(code) {
+			return string(code)
+		}
+	case formUTF32:
+		if len(code) == 4 {
+			if r := rune(binary.BigEndian.Uint32(code)); utf8.ValidRune(r) {
+				return string(r)
+			}
+		}
+	case formLegacy:
+		c := uint32(hexToInt(HexStringObject(code)))
+		if len(code) == 2 {
+			c += p.offset
+		}
+		if r, ok := p.table.lookup(c); ok {
+			return string(r)
+		}
+	}
+	return ""
+}
+
+// cidTables are the CID -> Unicode tables of the Adobe CJK character collections
+var cidTables = map[string]*codeTable{
+	"Adobe-Japan1": {file: "Adobe-Japan1-UCS2"},
+	"Adobe-GB1":    {file: "Adobe-GB1-UCS2"},
+	"Adobe-CNS1":   {file: "Adobe-CNS1-UCS2"},
+	"Adobe-Korea1": {file: "Adobe-Korea1-UCS2"},
+}
+
+// orderingToUnicode maps a CID of one of the Adobe CJK character collections
+// to Unicode. The proportional Roman block (CIDs 1-95), which all four
+// collections share, is also mapped when the collection's table is missing.
+func orderingToUnicode(ordering string, cid int) string {
+	if t := cidTables[ordering]; t != nil && cid >= 0 {
+		if r, ok := t.lookup(uint32(cid)); ok {
+			return string(r)
+		}
+	}
+	if cid < 1 || cid > 95 {
+		return ""
+	}
+	switch ordering {
+	case "Adobe-Japan1":
+		// JIS-Roman replaces backslash and tilde
+		switch cid {
+		case 61:
+			return "¥"
+		case 95:
+			return "‾"
+		}
+	case "Adobe-GB1", "Adobe-CNS1", "Adobe-Korea1":
+	default:
+		return ""
+	}
+	return string(rune(0x20 + cid - 1))
+}
@@ -3,27 +3,137 @@ package pdf
 import (
 	"bytes"
 	"io"
+	"sort"
 	"unicode/utf16"
 )
 
-// CMap represents the mapping from Character Codes (CIDs) to Unicode strings.
+// CMap maps character codes to Unicode strings (ToUnicode CMaps) or to
+// CIDs (encoding CMaps). Codes are 1-4 bytes long; the codespace ranges
+// define how a byte string is split into codes.
 type CMap struct {
-	SpaceWidth float64 // Fallback width
-	Map        map[string]string
+	SpaceWidth float64          // Fallback width
+	Codespaces []CodespaceRange // Valid code ranges (begincodespacerange)
+	WMode      int              // 0 = horizontal, 1 = vertical
+	UseCMap    string           // Parent CMap name from usecmap, resolved by the caller
+	Parent     *CMap            // Parent CMap consulted for codes this one does not map
+
+	bf        map[uint64]string // codeKey -> Unicode (bfchar/bfrange)
+	cidChars  map[uint64]int    // codeKey -> CID (cidchar)
+	cidRanges []cidRange        // Sorted by start key (cidrange)
+}
+
+// CodespaceRange is a range of valid character codes of a fixed byte length.
+// A code matches if each byte lies between the corresponding Low and High bytes.
+type CodespaceRange struct {
+	Low  []byte
+	High []byte
+}
+
+// cidRange maps codes lo..hi (same byte length) to consecutive CIDs starting at cid
+type cidRange struct {
+	lo, hi uint64 // codeKeys
+	cid    int
+}
+
+func (r CodespaceRange) contains(code []byte) bool {
+	if len(code) != len(r.Low) {
+		return false
+	}
+	for i, b := range code {
+		if b < r.Low[i] || b > r.High[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// codeKey packs a code and its byte length into one integer, so <41> and
+// <0041> stay distinct and lookups do not allocate.
+func codeKey(code []byte) uint64 {
+	var v uint64
+	for _, b := range code {
+		v = v<<8 | uint64(b)
+	}
+	return uint64(len(code))<<32 | v
+}
+
+// identityCMap is the predefined Identity-H/V CMap: 2-byte codes, CID = code
+var identityCMap = &CMap{
+	Codespaces: []CodespaceRange{{Low: []byte{0x00, 0x00}, High: []byte{0xFF, 0xFF}}},
+	cidRanges:  []cidRange{{lo: 2 << 32, hi: 2<<32 | 0xFFFF, cid: 0}},
 }
 
 func NewCMap() *CMap {
 	return &CMap{
-		Map: make(map[string]string),
+		bf:       make(map[uint64]string),
+		cidChars: make(map[uint64]int),
+	}
+}
+
+// Len returns the number of code -> Unicode mappings, including the parent's.
+func (c *CMap) Len() int {
+	n := len(c.bf)
+	if c.Parent != nil {
+		n += c.Parent.Len()
+	}
+	return n
+}
+
+// Lookup returns the Unicode string mapped to a character code.
+func (c *CMap) Lookup(code []byte) (string, bool) {
+	if len(code) == 0 || len(code) > 4 {
+		return "", false
+	}
+	return c.lookupKey(codeKey(code))
+}
+
+func (c *CMap) lookupKey(key uint64) (string, bool) {
+	for m := c; m != nil; m = m.Parent {
+		if s, ok := m.bf[key]; ok {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// CID returns the CID mapped to a character code.
+func (c *CMap) CID(code []byte) (int, bool) {
+	if len(code) == 0 || len(code) > 4 {
+		return 0, false
+	}
+	key := codeKey(code)
+	for m := c; m != nil; m = m.Parent {
+		if cid, ok := m.cidChars[key]; ok {
+			return cid, true
+		}
+		// Last range starting at or before key
+		i := sort.Search(len(m.cidRanges), func(i int) bool {
+			return m.cidRanges[i].lo > key
+		}) - 1
+		if i >= 0 && key <= m.cidRanges[i].hi {
+			return m.cidRanges[i].cid + int(key-m.cidRanges[i].lo), true
+		}
+	}
+	return 0, false
+}
+
+// parseCMapStream loads and parses the data of an embedded CMap stream
+func parseCMapStream(stm StreamObject) (*CMap, error) {
+	data, err := stm.Data()
+	if err != nil {
+		return nil, err
 	}
+	return ParseCMap(data)
 }
 
-// ParseCMap parses a ToUnicode stream.
+// ParseCMap parses a ToUnicode or embedded encoding CMap stream.
+// A truncated stream yields the mappings read so far.
 func ParseCMap(data []byte) (*CMap, error) {
 	cmap := NewCMap()
 	lexer := NewLexer(bytes.NewReader(data))
 
-	// Iterate objects to find beginbfchar / beginbfrange keywords
+	// Iterate objects to find the begin* keywords
+	var prev Object
 	for {
 		obj, err := lexer.ReadObject()
 		if err == io.EOF {
@@ -34,23 +144,110 @@ func ParseCMap(data []byte) (*CMap, error) {
 			continue
 		}
 
+		// /WMode 1 def
+		if name, ok := prev.(NameObject); ok && name == "/WMode" {
+			if mode, ok := obj.(NumberObject); ok {
+				cmap.WMode = int(mode)
+			}
+		}
+
 		// Check for keywords
 		if keyword, ok := obj.(KeywordObject); ok {
 			switch string(keyword) {
-			case "beginbfchar":
-				if err := parseBFChar(lexer, cmap); err != nil {
-					return nil, err
+			case "usecmap":
+				if name, ok := prev.(NameObject); ok {
+					cmap.UseCMap = string(name)
 				}
+			case "begincodespacerange":
+				err = parseCodespaceRange(lexer, cmap)
+			case "beginbfchar":
+				err = parseBFChar(lexer, cmap)
 			case "beginbfrange":
-				if err := parseBFRange(lexer, cmap); err != nil {
-					return nil, err
-				}
+				err = parseBFRange(lexer, cmap)
+			case "begincidchar":
+				err = parseCIDChar(lexer, cmap)
+			case "begincidrange":
+				err = parseCIDRange(lexer, cmap)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
 			}
 		}
+		prev = obj
 	}
+
+	sort.Slice(cmap.cidRanges, func(i, j int) bool {
+		return cmap.cidRanges[i].lo < cmap.cidRanges[j].lo
+	})
 	return cmap, nil
 }
 
+// parseCodespaceRange handles: <low> <high>
+func parseCodespaceRange(l *Lexer, cmap *CMap) error {
+	for {
+		lowObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		if keyword, ok := lowObj.(KeywordObject); ok {
+			if string(keyword) == "endcodespacerange" {
+				return nil
+			}
+			continue
+		}
+
+		highObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		low, ok1 := lowObj.(HexStringObject)
+		high, ok2 := highObj.(HexStringObject)
+		if ok1 && ok2 && len(low) == len(high) && len(low) > 0 && len(low) <= 4 {
+			cmap.Codespaces = append(cmap.Codespaces, CodespaceRange{Low: []byte(low), High: []byte(high)})
+		}
+	}
+}
+
+// codeLength returns the byte length of the character code at the start of data,
+// or 0 if neither this CMap nor its parents define codespace ranges.
+// Codes are matched against the codespace ranges from shortest to longest
+// (PDF 32000-1 9.7.6.2). Bytes that match no range are consumed using the
+// shortest range length so decoding stays in step.
+func (c *CMap) codeLength(data []byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	if len(c.Codespaces) == 0 {
+		if c.Parent != nil {
+			return c.Parent.codeLength(data)
+		}
+		return 0
+	}
+	shortest := 0
+	for n := 1; n <= 4 && n <= len(data); n++ {
+		for _, r := range c.Codespaces {
+			if len(r.Low) != n {
+				continue
+			}
+			if shortest == 0 {
+				shortest = n
+			}
+			if r.contains(data[:n]) {
+				return n
+			}
+		}
+	}
+	if shortest == 0 {
+		shortest = 1
+	}
+	return min(shortest, len(data))
+}
+
 // parseBFChar handles: <srcCode> <dstString>
 func parseBFChar(l *Lexer, cmap *CMap) error {
 	// Loop until endbfchar
@@ -76,12 +273,12 @@ func parseBFChar(l *Lexer, cmap *CMap) error {
 		}
 
 		srcHex, ok1 := srcObj.(HexStringObject)
-		dstHex, ok2 := dstObj.(HexStringObject)
+		dst, ok2 := cmapString(dstObj)
 
-		if ok1 && ok2 {
-			cmap.Map[string(srcHex)] = decodeUTF16BE(dstHex)
+		if ok1 && ok2 && len(srcHex) > 0 && len(srcHex) <= 4 {
+			cmap.bf[codeKey(srcHex)] = decodeUTF16BE(dst)
 		}
-		// If not both hex strings, skip this pair and continue
+		// If not both strings, skip this pair and continue
 	}
 }
 
@@ -118,47 +315,136 @@ func parseBFRange(l *Lexer, cmap *CMap) error {
 		startHex, startOk := startObj.(HexStringObject)
 		endHex, endOk := endObj.(HexStringObject)
 
-		if !startOk || !endOk {
+		if !startOk || !endOk || len(startHex) == 0 || len(startHex) > 4 {
 			// Skip invalid entries
 			continue
 		}
 
-		startCode := hexToInt(startHex)
-		endCode := hexToInt(endHex)
+		startKey := codeKey(startHex)
+		endKey := codeKey(padCode(endHex, len(startHex)))
+		if endKey < startKey || endKey-startKey > 0xFFFF {
+			continue
+		}
 
-		// Case 2: Array [<dst1> <dst2> ...]
+		// Case 2: Array [<dst1> <dst2> ...], one destination per code
 		if arr, ok := nextObj.(ArrayObject); ok {
 			for i, elem := range arr {
-				if hexStr, ok := elem.(HexStringObject); ok {
-					currentCode := startCode + i
-					if currentCode <= endCode {
-						key := intToHex(currentCode, len(startHex))
-						cmap.Map[key] = decodeUTF16BE(hexStr)
-					}
+				key := startKey + uint64(i)
+				if key > endKey {
+					break
+				}
+				if dst, ok := cmapString(elem); ok {
+					cmap.bf[key] = decodeUTF16BE(dst)
 				}
 			}
-		} else if dstStartHex, ok := nextObj.(HexStringObject); ok {
+		} else if dstStart, ok := cmapString(nextObj); ok && len(dstStart) >= 2 {
 			// Case 1: Sequential <dstStart>
-			// We map startCode..endCode to dstStart..dstStart+(diff)
-			// Logic: The destination code increments too.
-			// NOTE: Handle UTF16 incrementing carefully.
-
-			dstCode := hexToInt(dstStartHex)
-
-			for i := 0; i <= (endCode - startCode); i++ {
-				srcKey := intToHex(startCode+i, len(startHex))
-				// This is a simplification. Real unicode incrementing is complex.
-				// However, PDF spec says the last byte increments.
-				dstVal := intToHex(dstCode+i, len(dstStartHex))
-				cmap.Map[srcKey] = decodeUTF16BE(HexStringObject(dstVal))
+			// Each successive code increments the last UTF-16 unit of the destination,
+			// which keeps multi-character destinations (ligatures) intact.
+			dst := []byte(dstStart)
+			last := len(dst) - 2
+			base := int(dst[last])<<8 | int(dst[last+1])
+			for i := 0; uint64(i) <= endKey-startKey; i++ {
+				unit := base + i
+				dst[last], dst[last+1] = byte(unit>>8), byte(unit)
+				cmap.bf[startKey+uint64(i)] = decodeUTF16BE(dst)
 			}
 		}
-		// If nextObj is neither array nor hex string, skip this entry
+		// If nextObj is neither array nor string, skip this entry
+	}
+}
+
+// parseCIDChar handles: <srcCode> cid
+func parseCIDChar(l *Lexer, cmap *CMap) error {
+	for {
+		srcObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		if keyword, ok := srcObj.(KeywordObject); ok {
+			if string(keyword) == "endcidchar" {
+				return nil
+			}
+			continue
+		}
+
+		cidObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		src, ok1 := srcObj.(HexStringObject)
+		cid, ok2 := cidObj.(NumberObject)
+		if ok1 && ok2 && len(src) > 0 && len(src) <= 4 {
+			cmap.cidChars[codeKey(src)] = int(cid)
+		}
+	}
+}
+
+// parseCIDRange handles: <start> <end> cid
+func parseCIDRange(l *Lexer, cmap *CMap) error {
+	for {
+		startObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		if keyword, ok := startObj.(KeywordObject); ok {
+			if string(keyword) == "endcidrange" {
+				return nil
+			}
+			continue
+		}
+
+		endObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+		cidObj, err := l.ReadObject()
+		if err != nil {
+			return err
+		}
+
+		start, ok1 := startObj.(HexStringObject)
+		end, ok2 := endObj.(HexStringObject)
+		cid, ok3 := cidObj.(NumberObject)
+		if !ok1 || !ok2 || !ok3 || len(start) == 0 || len(start) > 4 {
+			continue
+		}
+		lo := codeKey(start)
+		hi := codeKey(padCode(end, len(start)))
+		if hi >= lo {
+			cmap.cidRanges = append(cmap.cidRanges, cidRange{lo: lo, hi: hi, cid: int(cid)})
+		}
 	}
 }
 
 // Helpers
 
+// cmapString accepts both hex and literal strings as CMap destinations
+func cmapString(obj Object) ([]byte, bool) {
+	switch s := obj.(type) {
+	case HexStringObject:
+		return []byte(s), true
+	case StringObject:
+		return []byte(s), true
+	}
+	return nil, false
+}
+
+// padCode left-pads or truncates a code to n bytes, for ranges whose
+// end code was written with a different length than the start code
+func padCode(code []byte, n int) []byte {
+	if len(code) == n {
+		return code
+	}
+	if len(code) > n {
+		return code[len(code)-n:]
+	}
+	return append(make([]byte, n-len(code)), code...)
+}
+
 func hexToInt(h HexStringObject) int {
 	// Convert bytes to integer
 	// <00 41> -> 65
@@ -170,16 +456,6 @@ func hexToInt(h HexStringObject) int {
 	return val
 }
 
-func intToHex(val int, byteLen int) string {
-	// Reconstruct hex string of specific length
-	out := make([]byte, byteLen)
-	for i := byteLen - 1; i >= 0; i-- {
-		out[i] = byte(val & 0xFF)
-		val >>= 8
-	}
-	return string(out)
-}
-
 func decodeUTF16BE(b []byte) string {
 	// Assuming b is Big Endian UTF-16
 	if len(b)%2 != 0 {
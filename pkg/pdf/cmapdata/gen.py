@@ -0,0 +1,261 @@
+#!/usr/bin/env python3
+"""Generates the compressed tables used for predefined CJK CMaps.
+
+Each table is a zlib stream of runs. A run maps count consecutive codes to
+count consecutive values and is stored as three varints:
+
+    uvarint(code - end of previous code run)
+    zigzag varint(value - end of previous value run)
+    uvarint(count)
+
+The national encoding tables (cp932.z, ...) map codes to Unicode and are
+built from Python's codecs. Given a checkout of Adobe's cmap-resources
+(https://github.com/adobe-type-tools/cmap-resources), the script also builds
+
+  - <CMap>.z for each predefined CMap in CMAPS: code -> CID, where codes
+    are stored with their byte length as (length << 32 | code). A -V table
+    only holds the codes it maps differently from its -H table.
+  - Adobe-<Ordering>-UCS2.z for the four CJK collections: CID -> Unicode.
+
+Run from this directory: python3 gen.py [path/to/cmap-resources]
+"""
+import glob
+import os
+import re
+import sys
+import zlib
+
+
+def single(lo, hi):
+    return [bytes([b]) for b in range(lo, hi + 1)]
+
+
+def double(leads, trails):
+    return [bytes([l, t]) for l in leads for t in trails]
+
+
+def r(lo, hi):
+    return range(lo, hi + 1)
+
+
+ascii_ = single(0x20, 0x7E)
+
+TABLES = {
+    # Shift-JIS (RKSJ CMaps)
+    "cp932": ("cp932", ascii_ + single(0xA1, 0xDF)
+              + double(list(r(0x81, 0x9F)) + list(r(0xE0, 0xFC)), r(0x40, 0xFC))),
+    # EUC-JP (EUC-H/V)
+    "eucjp": ("euc_jp", ascii_ + double([0x8E], r(0xA1, 0xDF))
+              + double(r(0xA1, 0xFE), r(0xA1, 0xFE))
+              + [b"\x8f" + c for c in double(r(0xA1, 0xFE), r(0xA1, 0xFE))]),
+    # GB 18030 (GB-EUC, GBpc-EUC, GBK-EUC, GBKp-EUC, GBK2K); GBK is its
+    # two-byte subset. Only the BMP part of the four-byte range is included.
+    "gb18030": ("gb18030", ascii_ + double(r(0x81, 0xFE), r(0x40, 0xFE))
+                + [bytes([a, b, c, d]) for a in r(0x81, 0x84) for b in r(0x30, 0x39)
+                   for c in r(0x81, 0xFE) for d in r(0x30, 0x39)]),
+    # Big Five (B5, B5pc, ETen-B5, ETenms-B5)
+    "cp950": ("cp950", ascii_ + double(r(0x81, 0xFE), list(r(0x40, 0x7E)) + list(r(0xA1, 0xFE)))),
+    # Hong Kong SCS (HKscs-B5)
+    "big5hkscs": ("big5hkscs", ascii_ + double(r(0x87, 0xFE), list(r(0x40, 0x7E)) + list(r(0xA1, 0xFE)))),
+    # Unified Hangul Code (KSC-EUC, KSCpc-EUC, KSCms-UHC)
+    "cp949": ("cp949", ascii_ + double(r(0x81, 0xFE), r(0x41, 0xFE))),
+}
+
+
+def decode(codec, code):
+    try:
+        s = code.decode(codec)
+    except UnicodeDecodeError:
+        return None
+    if len(s) != 1:
+        return None
+    u = ord(s)
+    # Private use and control characters carry no text
+    if 0xE000 <= u <= 0xF8FF or u < 0x20 or 0x7F <= u < 0xA0:
+        return None
+    return u
+
+
+def uvarint(v):
+    out = bytearray()
+    while v >= 0x80:
+        out.append((v & 0x7F) | 0x80)
+        v >>= 7
+    out.append(v)
+    return out
+
+
+def build(codec, codes):
+    pairs = []
+    for code in codes:
+        u = decode(codec, code)
+        if u is not None:
+            pairs.append((int.from_bytes(code, "big"), u))
+    return encode_runs(pairs), len(pairs)
+
+
+def encode_runs(pairs):
+    pairs = sorted(pairs)
+    runs = []
+    for c, v in pairs:
+        if runs and runs[-1][0] + runs[-1][2] == c and runs[-1][1] + runs[-1][2] == v:
+            runs[-1][2] += 1
+        else:
+            runs.append([c, v, 1])
+
+    out = bytearray()
+    prev_c = prev_v = 0
+    for c, v, n in runs:
+        out += uvarint(c - prev_c)
+        d = v - prev_v
+        out += uvarint((d << 1) ^ (d >> 63))
+        out += uvarint(n)
+        prev_c, prev_v = c + n, v + n
+    return zlib.compress(bytes(out), 9)
+
+
+# Predefined CMaps with a code -> CID table, by family; each has -H and -V
+# variants. The Uni* families are looked up through their UTF-32 CMap.
+CMAPS = [
+    "83pv-RKSJ", "90ms-RKSJ", "90msp-RKSJ", "90pv-RKSJ", "78-RKSJ", "78ms-RKSJ",
+    "Add-RKSJ", "Ext-RKSJ", "EUC", "78-EUC", "", "78", "Add", "Ext", "NWP",
+    "GB-EUC", "GBpc-EUC", "GBK-EUC", "GBKp-EUC", "GBK2K", "GB",
+    "B5", "B5pc", "ETen-B5", "ETenms-B5", "HKscs-B5",
+    "KSC-EUC", "KSCpc-EUC", "KSCms-UHC", "KSCms-UHC-HW", "KSC",
+    "UniJIS-UTF32", "UniJIS2004-UTF32", "UniJISX0213-UTF32",
+    "UniJISX02132004-UTF32", "UniGB-UTF32", "UniCNS-UTF32", "UniKS-UTF32",
+]
+
+ORDERINGS = ["Adobe-Japan1", "Adobe-GB1", "Adobe-CNS1", "Adobe-Korea1"]
+
+TOKEN = re.compile(rb"%[^\r\n]*|<([0-9A-Fa-f\s]*)>|[\[\]]|/?[^\s<>\[\]/%]+")
+
+
+def cmap_name(family, wmode):
+    return family + "-" + wmode if family else wmode
+
+
+def parse_cmap(files, name):
+    """Returns the mappings of a CMap file as {(length, code): value},
+    following usecmap. Values are CIDs for cidrange/cidchar, or the single
+    code point of a bfrange/bfchar UTF-16BE destination."""
+    with open(files[name], "rb") as f:
+        data = f.read()
+
+    out = {}
+    operands = []
+    section = None
+    for m in TOKEN.finditer(data):
+        tok = m.group(0)
+        if tok.startswith(b"%"):
+            continue
+        if m.group(1) is not None:
+            operands.append(bytes.fromhex(m.group(1).decode()))
+            continue
+        word = tok.decode("latin-1")
+        if word.isdigit():
+            operands.append(int(word))
+            continue
+        if word.startswith("/") or word in "[]":
+            operands.append(word)
+            continue
+
+        # Keywords
+        if word in ("begincidrange", "begincidchar", "beginbfrange", "beginbfchar"):
+            section = word[5:]
+        elif word == "end" + str(section):
+            map_entries(section, operands, out)
+            section = None
+        elif word == "usecmap" and operands:
+            parent = parse_cmap(files, operands[-1][1:])
+            parent.update(out)
+            out = parent
+        operands = []
+    return out
+
+
+def map_entries(section, ops, out):
+    """Adds the entries of one mapping section to out."""
+    i = 0
+    while i < len(ops):
+        if section == "cidrange":
+            lo, hi, cid = ops[i:i + 3]
+            i += 3
+            start = int.from_bytes(lo, "big")
+            for j in range(int.from_bytes(hi, "big") - start + 1):
+                out[(len(lo), start + j)] = cid + j
+        elif section == "cidchar":
+            code, cid = ops[i:i + 2]
+            i += 2
+            out[(len(code), int.from_bytes(code, "big"))] = cid
+        elif section == "bfchar":
+            code, dst = ops[i:i + 2]
+            i += 2
+            u = utf16_code_point(dst)
+            if u is not None:
+                out[(len(code), int.from_bytes(code, "big"))] = u
+        elif section == "bfrange":
+            lo, hi = ops[i:i + 2]
+            start, n = int.from_bytes(lo, "big"), int.from_bytes(hi, "big") - int.from_bytes(lo, "big") + 1
+            if ops[i + 2] == "[":
+                end = ops.index("]", i + 3)
+                dsts = [utf16_code_point(d) for d in ops[i + 3:end]]
+                i = end + 1
+            else:
+                # Consecutive destinations increment the last code point
+                base = utf16_code_point(ops[i + 2])
+                dsts = [None if base is None else base + j for j in range(n)]
+                i += 3
+            for j, u in enumerate(dsts[:n]):
+                if u is not None:
+                    out[(len(lo), start + j)] = u
+
+
+def utf16_code_point(b):
+    """Decodes a bf destination holding exactly one code point."""
+    if not isinstance(b, bytes):
+        return None
+    try:
+        s = b.decode("utf-16-be")
+    except UnicodeDecodeError:
+        return None
+    return ord(s) if len(s) == 1 else None
+
+
+def build_adobe(root):
+    files = {}
+    for path in glob.glob(os.path.join(root, "*", "CMap", "*")):
+        files.setdefault(os.path.basename(path), path)
+
+    for family in CMAPS:
+        if cmap_name(family, "H") not in files:
+            print("%-22s missing" % cmap_name(family, "H"))
+            continue
+        h = parse_cmap(files, cmap_name(family, "H"))
+        v = parse_cmap(files, cmap_name(family, "V"))
+        v = {k: cid for k, cid in v.items() if h.get(k) != cid}
+        for wmode, table in (("H", h), ("V", v)):
+            name = cmap_name(family, wmode)
+            write(name, encode_runs([(n << 32 | c, cid) for (n, c), cid in table.items()]), len(table))
+
+    for ordering in ORDERINGS:
+        name = ordering + "-UCS2"
+        if name not in files:
+            print("%-22s missing" % name)
+            continue
+        table = parse_cmap(files, name)
+        write(name, encode_runs([(c, u) for (n, c), u in table.items()]), len(table))
+
+
+def write(name, data, n):
+    with open(name + ".z", "wb") as f:
+        f.write(data)
+    print("%-22s %6d codes %7d bytes" % (name, n, len(data)))
+
+
+if __name__ == "__main__":
+    for name, (codec, codes) in TABLES.items():
+        data, n = build(codec, codes)
+        write(name, data, n)
+    if len(sys.argv) > 1:
+        build_adobe(sys.argv[1])
@@ -45,7 +45,7 @@ func (p *ContentStreamParser) Next() (*Operation, error) {
 
 		obj, err := p.lexer.ReadObject()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read object: %v", err)
+			return nil, fmt.Errorf("failed to read object: %w", err)
 		}
 
 		// Check if it's an operator (keyword)
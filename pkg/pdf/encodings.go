@@ -0,0 +1,374 @@
+package pdf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Standard single-byte encodings from Annex D of the PDF specification.
+// Each table maps a character code to a glyph name; undefined codes are empty.
+
+// standardEncoding is the Adobe StandardEncoding, the built-in encoding of
+// most Latin Type 1 fonts.
+var standardEncoding = [256]string{
+	0x20: "/space", 0x21: "/exclam", 0x22: "/quotedbl", 0x23: "/numbersign",
+	0x24: "/dollar", 0x25: "/percent", 0x26: "/ampersand", 0x27: "/quoteright",
+	0x28: "/parenleft", 0x29: "/parenright", 0x2A: "/asterisk", 0x2B: "/plus",
+	0x2C: "/comma", 0x2D: "/hyphen", 0x2E: "/period", 0x2F: "/slash",
+	0x30: "/zero", 0x31: "/one", 0x32: "/two", 0x33: "/three",
+	0x34: "/four", 0x35: "/five", 0x36: "/six", 0x37: "/seven",
+	0x38: "/eight", 0x39: "/nine", 0x3A: "/colon", 0x3B: "/semicolon",
+	0x3C: "/less", 0x3D: "/equal", 0x3E: "/greater", 0x3F: "/question",
+	0x40: "/at", 0x41: "/A", 0x42: "/B", 0x43: "/C",
+	0x44: "/D", 0x45: "/E", 0x46: "/F", 0x47: "/G",
+	0x48: "/H", 0x49: "/I", 0x4A: "/J", 0x4B: "/K",
+	0x4C: "/L", 0x4D: "/M", 0x4E: "/N", 0x4F: "/O",
+	0x50: "/P", 0x51: "/Q", 0x52: "/R", 0x53: "/S",
+	0x54: "/T", 0x55: "/U", 0x56: "/V", 0x57: "/W",
+	0x58: "/X", 0x59: "/Y", 0x5A: "/Z", 0x5B: "/bracketleft",
+	0x5C: "/backslash", 0x5D: "/bracketright", 0x5E: "/asciicircum", 0x5F: "/underscore",
+	0x60: "/quoteleft", 0x61: "/a", 0x62: "/b", 0x63: "/c",
+	0x64: "/d", 0x65: "/e", 0x66: "/f", 0x67: "/g",
+	0x68: "/h", 0x69: "/i", 0x6A: "/j", 0x6B: "/k",
+	0x6C: "/l", 0x6D: "/m", 0x6E: "/n", 0x6F: "/o",
+	0x70: "/p", 0x71: "/q", 0x72: "/r", 0x73: "/s",
+	0x74: "/t", 0x75: "/u", 0x76: "/v", 0x77: "/w",
+	0x78: "/x", 0x79: "/y", 0x7A: "/z", 0x7B: "/braceleft",
+	0x7C: "/bar", 0x7D: "/braceright", 0x7E: "/asciitilde", 0xA1: "/exclamdown",
+	0xA2: "/cent", 0xA3: "/sterling", 0xA4: "/fraction", 0xA5: "/yen",
+	0xA6: "/florin", 0xA7: "/section", 0xA8: "/currency", 0xA9: "/quotesingle",
+	0xAA: "/quotedblleft", 0xAB: "/guillemotleft", 0xAC: "/guilsinglleft", 0xAD: "/guilsinglright",
+	0xAE: "/fi", 0xAF: "/fl", 0xB1: "/endash", 0xB2: "/dagger",
+	0xB3: "/daggerdbl", 0xB4: "/periodcentered", 0xB6: "/paragraph", 0xB7: "/bullet",
+	0xB8: "/quotesinglbase", 0xB9: "/quotedblbase", 0xBA: "/quotedblright", 0xBB: "/guillemotright",
+	0xBC: "/ellipsis", 0xBD: "/perthousand", 0xBF: "/questiondown", 0xC1: "/grave",
+	0xC2: "/acute", 0xC3: "/circumflex", 0xC4: "/tilde", 0xC5: "/macron",
+	0xC6: "/breve", 0xC7: "/dotaccent", 0xC8: "/dieresis", 0xCA: "/ring",
+	0xCB: "/cedilla", 0xCD: "/hungarumlaut", 0xCE: "/ogonek", 0xCF: "/caron",
+	0xD0: "/emdash", 0xE1: "/AE", 0xE3: "/ordfeminine", 0xE8: "/Lslash",
+	0xE9: "/Oslash", 0xEA: "/OE", 0xEB: "/ordmasculine", 0xF1: "/ae",
+	0xF5: "/dotlessi", 0xF8: "/lslash", 0xF9: "/oslash", 0xFA: "/oe",
+	0xFB: "/germandbls",
+}
+
+// winAnsiEncoding is Windows code page 1252. Undefined codes 0x7F, 0x81,
+// 0x8D, 0x8F, 0x90 and 0x9D map to bullet, as Acrobat does.
+var winAnsiEncoding = [256]string{
+	0x20: "/space", 0x21: "/exclam", 0x22: "/quotedbl", 0x23: "/numbersign",
+	0x24: "/dollar", 0x25: "/percent", 0x26: "/ampersand", 0x27: "/quotesingle",
+	0x28: "/parenleft", 0x29: "/parenright", 0x2A: "/asterisk", 0x2B: "/plus",
+	0x2C: "/comma", 0x2D: "/hyphen", 0x2E: "/period", 0x2F: "/slash",
+	0x30: "/zero", 0x31: "/one", 0x32: "/two", 0x33: "/three",
+	0x34: "/four", 0x35: "/five", 0x36: "/six", 0x37: "/seven",
+	0x38: "/eight", 0x39: "/nine", 0x3A: "/colon", 0x3B: "/semicolon",
+	0x3C: "/less", 0x3D: "/equal", 0x3E: "/greater", 0x3F: "/question",
+	0x40: "/at", 0x41: "/A", 0x42: "/B", 0x43: "/C",
+	0x44: "/D", 0x45: "/E", 0x46: "/F", 0x47: "/G",
+	0x48: "/H", 0x49: "/I", 0x4A: "/J", 0x4B: "/K",
+	0x4C: "/L", 0x4D: "/M", 0x4E: "/N", 0x4F: "/O",
+	0x50: "/P", 0x51: "/Q", 0x52: "/R", 0x53: "/S",
+	0x54: "/T", 0x55: "/U", 0x56: "/V", 0x57: "/W",
+	0x58: "/X", 0x59: "/Y", 0x5A: "/Z", 0x5B: "/bracketleft",
+	0x5C: "/backslash", 0x5D: "/bracketright", 0x5E: "/asciicircum", 0x5F: "/underscore",
+	0x60: "/grave", 0x61: "/a", 0x62: "/b", 0x63: "/c",
+	0x64: "/d", 0x65: "/e", 0x66: "/f", 0x67: "/g",
+	0x68: "/h", 0x69: "/i", 0x6A: "/j", 0x6B: "/k",
+	0x6C: "/l", 0x6D: "/m", 0x6E: "/n", 0x6F: "/o",
+	0x70: "/p", 0x71: "/q", 0x72: "/r", 0x73: "/s",
+	0x74: "/t", 0x75: "/u", 0x76: "/v", 0x77: "/w",
+	0x78: "/x", 0x79: "/y", 0x7A: "/z", 0x7B: "/braceleft",
+	0x7C: "/bar", 0x7D: "/braceright", 0x7E: "/asciitilde", 0x7F: "/bullet",
+	0x80: "/Euro", 0x81: "/bullet", 0x82: "/quotesinglbase", 0x83: "/florin",
+	0x84: "/quotedblbase", 0x85: "/ellipsis", 0x86: "/dagger", 0x87: "/daggerdbl",
+	0x88: "/circumflex", 0x89: "/perthousand", 0x8A: "/Scaron", 0x8B: "/guilsinglleft",
+	0x8C: "/OE", 0x8D: "/bullet", 0x8E: "/Zcaron", 0x8F: "/bullet",
+	0x90: "/bullet", 0x91: "/quoteleft", 0x92: "/quoteright", 0x93: "/quotedblleft",
+	0x94: "/quotedblright", 0x95: "/bullet", 0x96: "/endash", 0x97: "/emdash",
+	0x98: "/tilde", 0x99: "/trademark", 0x9A: "/scaron", 0x9B: "/guilsinglright",
+	0x9C: "/oe", 0x9D: "/bullet", 0x9E: "/zcaron", 0x9F: "/Ydieresis",
+	0xA0: "/space", 0xA1: "/exclamdown", 0xA2: "/cent", 0xA3: "/sterling",
+	0xA4: "/currency", 0xA5: "/yen", 0xA6: "/brokenbar", 0xA7: "/section",
+	0xA8: "/dieresis", 0xA9: "/copyright", 0xAA: "/ordfeminine", 0xAB: "/guillemotleft",
+	0xAC: "/logicalnot", 0xAD: "/hyphen", 0xAE: "/registered", 0xAF: "/macron",
+	0xB0: "/degree", 0xB1: "/plusminus", 0xB2: "/twosuperior", 0xB3: "/threesuperior",
+	0xB4: "/acute", 0xB5: "/mu", 0xB6: "/paragraph", 0xB7: "/periodcentered",
+	0xB8: "/cedilla", 0xB9: "/onesuperior", 0xBA: "/ordmasculine", 0xBB: "/guillemotright",
+	0xBC: "/onequarter", 0xBD: "/onehalf", 0xBE: "/threequarters", 0xBF: "/questiondown",
+	0xC0: "/Agrave", 0xC1: "/Aacute", 0xC2: "/Acircumflex", 0xC3: "/Atilde",
+	0xC4: "/Adieresis", 0xC5: "/Aring", 0xC6: "/AE", 0xC7: "/Ccedilla",
+	0xC8: "/Egrave", 0xC9: "/Eacute", 0xCA: "/Ecircumflex", 0xCB: "/Edieresis",
+	0xCC: "/Igrave", 0xCD: "/Iacute", 0xCE: "/Icircumflex", 0xCF: "/Idieresis",
+	0xD0: "/Eth", 0xD1: "/Ntilde", 0xD2: "/Ograve", 0xD3: "/Oacute",
+	0xD4: "/Ocircumflex", 0xD5: "/Otilde", 0xD6: "/Odieresis", 0xD7: "/multiply",
+	0xD8: "/Oslash", 0xD9: "/Ugrave", 0xDA: "/Uacute", 0xDB: "/Ucircumflex",
+	0xDC: "/Udieresis", 0xDD: "/Yacute", 0xDE: "/Thorn", 0xDF: "/germandbls",
+	0xE0: "/agrave", 0xE1: "/aacute", 0xE2: "/acircumflex", 0xE3: "/atilde",
+	0xE4: "/adieresis", 0xE5: "/aring", 0xE6: "/ae", 0xE7: "/ccedilla",
+	0xE8: "/egrave", 0xE9: "/eacute", 0xEA: "/ecircumflex", 0xEB: "/edieresis",
+	0xEC: "/igrave", 0xED: "/iacute", 0xEE: "/icircumflex", 0xEF: "/idieresis",
+	0xF0: "/eth", 0xF1: "/ntilde", 0xF2: "/ograve", 0xF3: "/oacute",
+	0xF4: "/ocircumflex", 0xF5: "/otilde", 0xF6: "/odieresis", 0xF7: "/divide",
+	0xF8: "/oslash", 0xF9: "/ugrave", 0xFA: "/uacute", 0xFB: "/ucircumflex",
+	0xFC: "/udieresis", 0xFD: "/yacute", 0xFE: "/thorn", 0xFF: "/ydieresis",
+}
+
+// macRomanEncoding is the Mac OS Roman character set (0xDB is currency,
+// not the Euro sign used by later Mac OS versions).
+var macRomanEncoding = [256]string{
+	0x20: "/space", 0x21: "/exclam", 0x22: "/quotedbl", 0x23: "/numbersign",
+	0x24: "/dollar", 0x25: "/percent", 0x26: "/ampersand", 0x27: "/quotesingle",
+	0x28: "/parenleft", 0x29: "/parenright", 0x2A: "/asterisk", 0x2B: "/plus",
+	0x2C: "/comma", 0x2D: "/hyphen", 0x2E: "/period", 0x2F: "/slash",
+	0x30: "/zero", 0x31: "/one", 0x32: "/two", 0x33: "/three",
+	0x34: "/four", 0x35: "/five", 0x36: "/six", 0x37: "/seven",
+	0x38: "/eight", 0x39: "/nine", 0x3A: "/colon", 0x3B: "/semicolon",
+	0x3C: "/less", 0x3D: "/equal", 0x3E: "/greater", 0x3F: "/question",
+	0x40: "/at", 0x41: "/A", 0x42: "/B", 0x43: "/C",
+	0x44: "/D", 0x45: "/E", 0x46: "/F", 0x47: "/G",
+	0x48: "/H", 0x49: "/I", 0x4A: "/J", 0x4B: "/K",
+	0x4C: "/L", 0x4D: "/M", 0x4E: "/N", 0x4F: "/O",
+	0x50: "/P", 0x51: "/Q", 0x52: "/R", 0x53: "/S",
+	0x54: "/T", 0x55: "/U", 0x56: "/V", 0x57: "/W",
+	0x58: "/X", 0x59: "/Y", 0x5A: "/Z", 0x5B: "/bracketleft",
+	0x5C: "/backslash", 0x5D: "/bracketright", 0x5E: "/asciicircum", 0x5F: "/underscore",
+	0x60: "/grave", 0x61: "/a", 0x62: "/b", 0x63: "/c",
+	0x64: "/d", 0x65: "/e", 0x66: "/f", 0x67: "/g",
+	0x68: "/h", 0x69: "/i", 0x6A: "/j", 0x6B: "/k",
+	0x6C: "/l", 0x6D: "/m", 0x6E: "/n", 0x6F: "/o",
+	0x70: "/p", 0x71: "/q", 0x72: "/r", 0x73: "/s",
+	0x74: "/t", 0x75: "/u", 0x76: "/v", 0x77: "/w",
+	0x78: "/x", 0x79: "/y", 0x7A: "/z", 0x7B: "/braceleft",
+	0x7C: "/bar", 0x7D: "/braceright", 0x7E: "/asciitilde", 0x80: "/Adieresis",
+	0x81: "/Aring", 0x82: "/Ccedilla", 0x83: "/Eacute", 0x84: "/Ntilde",
+	0x85: "/Odieresis", 0x86: "/Udieresis", 0x87: "/aacute", 0x88: "/agrave",
+	0x89: "/acircumflex", 0x8A: "/adieresis", 0x8B: "/atilde", 0x8C: "/aring",
+	0x8D: "/ccedilla", 0x8E: "/eacute", 0x8F: "/egrave", 0x90: "/ecircumflex",
+	0x91: "/edieresis", 0x92: "/iacute", 0x93: "/igrave", 0x94: "/icircumflex",
+	0x95: "/idieresis", 0x96: "/ntilde", 0x97: "/oacute", 0x98: "/ograve",
+	0x99: "/ocircumflex", 0x9A: "/odieresis", 0x9B: "/otilde", 0x9C: "/uacute",
+	0x9D: "/ugrave", 0x9E: "/ucircumflex", 0x9F: "/udieresis", 0xA0: "/dagger",
+	0xA1: "/degree", 0xA2: "/cent", 0xA3: "/sterling", 0xA4: "/section",
+	0xA5: "/bullet", 0xA6: "/paragraph", 0xA7: "/germandbls", 0xA8: "/registered",
+	0xA9: "/copyright", 0xAA: "/trademark", 0xAB: "/acute", 0xAC: "/dieresis",
+	0xAD: "/notequal", 0xAE: "/AE", 0xAF: "/Oslash", 0xB0: "/infinity",
+	0xB1: "/plusminus", 0xB2: "/lessequal", 0xB3: "/greaterequal", 0xB4: "/yen",
+	0xB5: "/mu", 0xB6: "/partialdiff", 0xB7: "/summation", 0xB8: "/product",
+	0xB9: "/pi", 0xBA: "/integral", 0xBB: "/ordfeminine", 0xBC: "/ordmasculine",
+	0xBD: "/Omega", 0xBE: "/ae", 0xBF: "/oslash", 0xC0: "/questiondown",
+	0xC1: "/exclamdown", 0xC2: "/logicalnot", 0xC3: "/radical", 0xC4: "/florin",
+	0xC5: "/approxequal", 0xC6: "/Delta", 0xC7: "/guillemotleft", 0xC8: "/guillemotright",
+	0xC9: "/ellipsis", 0xCA: "/space", 0xCB: "/Agrave", 0xCC: "/Atilde",
+	0xCD: "/Otilde", 0xCE: "/OE", 0xCF: "/oe", 0xD0: "/endash",
+	0xD1: "/emdash", 0xD2: "/quotedblleft", 0xD3: "/quotedblright", 0xD4: "/quoteleft",
+	0xD5: "/quoteright", 0xD6: "/divide", 0xD7: "/lozenge", 0xD8: "/ydieresis",
+	0xD9: "/Ydieresis", 0xDA: "/fraction", 0xDB: "/currency", 0xDC: "/guilsinglleft",
+	0xDD: "/guilsinglright", 0xDE: "/fi", 0xDF: "/fl", 0xE0: "/daggerdbl",
+	0xE1: "/periodcentered", 0xE2: "/quotesinglbase", 0xE3: "/quotedblbase", 0xE4: "/perthousand",
+	0xE5: "/Acircumflex", 0xE6: "/Ecircumflex", 0xE7: "/Aacute", 0xE8: "/Edieresis",
+	0xE9: "/Egrave", 0xEA: "/Iacute", 0xEB: "/Icircumflex", 0xEC: "/Idieresis",
+	0xED: "/Igrave", 0xEE: "/Oacute", 0xEF: "/Ocircumflex", 0xF1: "/Ograve",
+	0xF2: "/Uacute", 0xF3: "/Ucircumflex", 0xF4: "/Ugrave", 0xF5: "/dotlessi",
+	0xF6: "/circumflex", 0xF7: "/tilde", 0xF8: "/macron", 0xF9: "/breve",
+	0xFA: "/dotaccent", 0xFB: "/ring", 0xFC: "/cedilla", 0xFD: "/hungarumlaut",
+	0xFE: "/ogonek", 0xFF: "/caron",
+}
+
+// pdfDocEncoding is the encoding of PDF text strings outside fonts.
+var pdfDocEncoding = [256]string{
+	0x18: "/breve", 0x19: "/caron", 0x1A: "/circumflex", 0x1B: "/dotaccent",
+	0x1C: "/hungarumlaut", 0x1D: "/ogonek", 0x1E: "/ring", 0x1F: "/tilde",
+	0x20: "/space", 0x21: "/exclam", 0x22: "/quotedbl", 0x23: "/numbersign",
+	0x24: "/dollar", 0x25: "/percent", 0x26: "/ampersand", 0x27: "/quotesingle",
+	0x28: "/parenleft", 0x29: "/parenright", 0x2A: "/asterisk", 0x2B: "/plus",
+	0x2C: "/comma", 0x2D: "/hyphen", 0x2E: "/period", 0x2F: "/slash",
+	0x30: "/zero", 0x31: "/one", 0x32: "/two", 0x33: "/three",
+	0x34: "/four", 0x35: "/five", 0x36: "/six", 0x37: "/seven",
+	0x38: "/eight", 0x39: "/nine", 0x3A: "/colon", 0x3B: "/semicolon",
+	0x3C: "/less", 0x3D: "/equal", 0x3E: "/greater", 0x3F: "/question",
+	0x40: "/at", 0x41: "/A", 0x42: "/B", 0x43: "/C",
+	0x44: "/D", 0x45: "/E", 0x46: "/F", 0x47: "/G",
+	0x48: "/H", 0x49: "/I", 0x4A: "/J", 0x4B: "/K",
+	0x4C: "/L", 0x4D: "/M", 0x4E: "/N", 0x4F: "/O",
+	0x50: "/P", 0x51: "/Q", 0x52: "/R", 0x53: "/S",
+	0x54: "/T", 0x55: "/U", 0x56: "/V", 0x57: "/W",
+	0x58: "/X", 0x59: "/Y", 0x5A: "/Z", 0x5B: "/bracketleft",
+	0x5C: "/backslash", 0x5D: "/bracketright", 0x5E: "/asciicircum", 0x5F: "/underscore",
+	0x60: "/grave", 0x61: "/a", 0x62: "/b", 0x63: "/c",
+	0x64: "/d", 0x65: "/e", 0x66: "/f", 0x67: "/g",
+	0x68: "/h", 0x69: "/i", 0x6A: "/j", 0x6B: "/k",
+	0x6C: "/l", 0x6D: "/m", 0x6E: "/n", 0x6F: "/o",
+	0x70: "/p", 0x71: "/q", 0x72: "/r", 0x73: "/s",
+	0x74: "/t", 0x75: "/u", 0x76: "/v", 0x77: "/w",
+	0x78: "/x", 0x79: "/y", 0x7A: "/z", 0x7B: "/braceleft",
+	0x7C: "/bar", 0x7D: "/braceright", 0x7E: "/asciitilde", 0x80: "/bullet",
+	0x81: "/dagger", 0x82: "/daggerdbl", 0x83: "/ellipsis", 0x84: "/emdash",
+	0x85: "/endash", 0x86: "/florin", 0x87: "/fraction", 0x88: "/guilsinglleft",
+	0x89: "/guilsinglright", 0x8A: "/minus", 0x8B: "/perthousand", 0x8C: "/quotedblbase",
+	0x8D: "/quotedblleft", 0x8E: "/quotedblright", 0x8F: "/quoteleft", 0x90: "/quoteright",
+	0x91: "/quotesinglbase", 0x92: "/trademark", 0x93: "/fi", 0x94: "/fl",
+	0x95: "/Lslash", 0x96: "/OE", 0x97: "/Scaron", 0x98: "/Ydieresis",
+	0x99: "/Zcaron", 0x9A: "/dotlessi", 0x9B: "/lslash", 0x9C: "/oe",
+	0x9D: "/scaron", 0x9E: "/zcaron", 0xA0: "/Euro", 0xA1: "/exclamdown",
+	0xA2: "/cent", 0xA3: "/sterling", 0xA4: "/currency", 0xA5: "/yen",
+	0xA6: "/brokenbar", 0xA7: "/section", 0xA8: "/dieresis", 0xA9: "/copyright",
+	0xAA: "/ordfeminine", 0xAB: "/guillemotleft", 0xAC: "/logicalnot", 0xAE: "/registered",
+	0xAF: "/macron", 0xB0: "/degree", 0xB1: "/plusminus", 0xB2: "/twosuperior",
+	0xB3: "/threesuperior", 0xB4: "/acute", 0xB5: "/mu", 0xB6: "/paragraph",
+	0xB7: "/periodcentered", 0xB8: "/cedilla", 0xB9: "/onesuperior", 0xBA: "/ordmasculine",
+	0xBB: "/guillemotright", 0xBC: "/onequarter", 0xBD: "/onehalf", 0xBE: "/threequarters",
+	0xBF: "/questiondown", 0xC0: "/Agrave", 0xC1: "/Aacute", 0xC2: "/Acircumflex",
+	0xC3: "/Atilde", 0xC4: "/Adieresis", 0xC5: "/Aring", 0xC6: "/AE",
+	0xC7: "/Ccedilla", 0xC8: "/Egrave", 0xC9: "/Eacute", 0xCA: "/Ecircumflex",
+	0xCB: "/Edieresis", 0xCC: "/Igrave", 0xCD: "/Iacute", 0xCE: "/Icircumflex",
+	0xCF: "/Idieresis", 0xD0: "/Eth", 0xD1: "/Ntilde", 0xD2: "/Ograve",
+	0xD3: "/Oacute", 0xD4: "/Ocircumflex", 0xD5: "/Otilde", 0xD6: "/Odieresis",
+	0xD7: "/multiply", 0xD8: "/Oslash", 0xD9: "/Ugrave", 0xDA: "/Uacute",
+	0xDB: "/Ucircumflex", 0xDC: "/Udieresis", 0xDD: "/Yacute", 0xDE: "/Thorn",
+	0xDF: "/germandbls", 0xE0: "/agrave", 0xE1: "/aacute", 0xE2: "/acircumflex",
+	0xE3: "/atilde", 0xE4: "/adieresis", 0xE5: "/aring", 0xE6: "/ae",
+	0xE7: "/ccedilla", 0xE8: "/egrave", 0xE9: "/eacute", 0xEA: "/ecircumflex",
+	0xEB: "/edieresis", 0xEC: "/igrave", 0xED: "/iacute", 0xEE: "/icircumflex",
+	0xEF: "/idieresis", 0xF0: "/eth", 0xF1: "/ntilde", 0xF2: "/ograve",
+	0xF3: "/oacute", 0xF4: "/ocircumflex", 0xF5: "/otilde", 0xF6: "/odieresis",
+	0xF7: "/divide", 0xF8: "/oslash", 0xF9: "/ugrave", 0xFA: "/uacute",
+	0xFB: "/ucircumflex", 0xFC: "/udieresis", 0xFD: "/yacute", 0xFE: "/thorn",
+	0xFF: "/ydieresis",
+}
+
+// symbolEncoding is the built-in encoding of the standard Symbol font.
+var symbolEncoding = [256]string{
+	0x20: "/space", 0x21: "/exclam", 0x22: "/universal", 0x23: "/numbersign",
+	0x24: "/existential", 0x25: "/percent", 0x26: "/ampersand", 0x27: "/suchthat",
+	0x28: "/parenleft", 0x29: "/parenright", 0x2A: "/asteriskmath", 0x2B: "/plus",
+	0x2C: "/comma", 0x2D: "/minus", 0x2E: "/period", 0x2F: "/slash",
+	0x30: "/zero", 0x31: "/one", 0x32: "/two", 0x33: "/three",
+	0x34: "/four", 0x35: "/five", 0x36: "/six", 0x37: "/seven",
+	0x38: "/eight", 0x39: "/nine", 0x3A: "/colon", 0x3B: "/semicolon",
+	0x3C: "/less", 0x3D: "/equal", 0x3E: "/greater", 0x3F: "/question",
+	0x40: "/congruent", 0x41: "/Alpha", 0x42: "/Beta", 0x43: "/Chi",
+	0x44: "/Delta", 0x45: "/Epsilon", 0x46: "/Phi", 0x47: "/Gamma",
+	0x48: "/Eta", 0x49: "/Iota", 0x4A: "/theta1", 0x4B: "/Kappa",
+	0x4C: "/Lambda", 0x4D: "/Mu", 0x4E: "/Nu", 0x4F: "/Omicron",
+	0x50: "/Pi", 0x51: "/Theta", 0x52: "/Rho", 0x53: "/Sigma",
+	0x54: "/Tau", 0x55: "/Upsilon", 0x56: "/sigma1", 0x57: "/Omega",
+	0x58: "/Xi", 0x59: "/Psi", 0x5A: "/Zeta", 0x5B: "/bracketleft",
+	0x5C: "/therefore", 0x5D: "/bracketright", 0x5E: "/perpendicular", 0x5F: "/underscore",
+	0x60: "/radicalex", 0x61: "/alpha", 0x62: "/beta", 0x63: "/chi",
+	0x64: "/delta", 0x65: "/epsilon", 0x66: "/phi", 0x67: "/gamma",
+	0x68: "/eta", 0x69: "/iota", 0x6A: "/phi1", 0x6B: "/kappa",
+	0x6C: "/lambda", 0x6D: "/mu", 0x6E: "/nu", 0x6F: "/omicron",
+	0x70: "/pi", 0x71: "/theta", 0x72: "/rho", 0x73: "/sigma",
+	0x74: "/tau", 0x75: "/upsilon", 0x76: "/omega1", 0x77: "/omega",
+	0x78: "/xi", 0x79: "/psi", 0x7A: "/zeta", 0x7B: "/braceleft",
+	0x7C: "/bar", 0x7D: "/braceright", 0x7E: "/similar", 0xA0: "/Euro",
+	0xA1: "/Upsilon1", 0xA2: "/minute", 0xA3: "/lessequal", 0xA4: "/fraction",
+	0xA5: "/infinity", 0xA6: "/florin", 0xA7: "/club", 0xA8: "/diamond",
+	0xA9: "/heart", 0xAA: "/spade", 0xAB: "/arrowboth", 0xAC: "/arrowleft",
+	0xAD: "/arrowup", 0xAE: "/arrowright", 0xAF: "/arrowdown", 0xB0: "/degree",
+	0xB1: "/plusminus", 0xB2: "/second", 0xB3: "/greaterequal", 0xB4: "/multiply",
+	0xB5: "/proportional", 0xB6: "/partialdiff", 0xB7: "/bullet", 0xB8: "/divide",
+	0xB9: "/notequal", 0xBA: "/equivalence", 0xBB: "/approxequal", 0xBC: "/ellipsis",
+	0xBD: "/arrowvertex", 0xBE: "/arrowhorizex", 0xBF: "/carriagereturn", 0xC0: "/aleph",
+	0xC1: "/Ifraktur", 0xC2: "/Rfraktur", 0xC3: "/weierstrass", 0xC4: "/circlemultiply",
+	0xC5: "/circleplus", 0xC6: "/emptyset", 0xC7: "/intersection", 0xC8: "/union",
+	0xC9: "/propersuperset", 0xCA: "/reflexsuperset", 0xCB: "/notsubset", 0xCC: "/propersubset",
+	0xCD: "/reflexsubset", 0xCE: "/element", 0xCF: "/notelement", 0xD0: "/angle",
+	0xD1: "/gradient", 0xD2: "/registerserif", 0xD3: "/copyrightserif", 0xD4: "/trademarkserif",
+	0xD5: "/product", 0xD6: "/radical", 0xD7: "/dotmath", 0xD8: "/logicalnot",
+	0xD9: "/logicaland", 0xDA: "/logicalor", 0xDB: "/arrowdblboth", 0xDC: "/arrowdblleft",
+	0xDD: "/arrowdblup", 0xDE: "/arrowdblright", 0xDF: "/arrowdbldown", 0xE0: "/lozenge",
+	0xE1: "/angleleft", 0xE2: "/registersans", 0xE3: "/copyrightsans", 0xE4: "/trademarksans",
+	0xE5: "/summation", 0xE6: "/parenlefttp", 0xE7: "/parenleftex", 0xE8: "/parenleftbt",
+	0xE9: "/bracketlefttp", 0xEA: "/bracketleftex", 0xEB: "/bracketleftbt", 0xEC: "/bracelefttp",
+	0xED: "/braceleftmid", 0xEE: "/braceleftbt", 0xEF: "/braceex", 0xF1: "/angleright",
+	0xF2: "/integral", 0xF3: "/integraltp", 0xF4: "/integralex", 0xF5: "/integralbt",
+	0xF6: "/parenrighttp", 0xF7: "/parenrightex", 0xF8: "/parenrightbt", 0xF9: "/bracketrighttp",
+	0xFA: "/bracketrightex", 0xFB: "/bracketrightbt", 0xFC: "/bracerighttp", 0xFD: "/bracerightmid",
+	0xFE: "/bracerightbt",
+}
+
+// zapfDingbatsEncoding is the built-in encoding of the standard ZapfDingbats
+// font. The Unicode Dingbats block was laid out after this font, so most codes
+// map to U+2700 plus an offset; the exceptions are characters that already
+// existed elsewhere in Unicode. Glyph names use the uniXXXX form.
+var zapfDingbatsEncoding = func() [256]string {
+	var enc [256]string
+	set := func(code int, r rune) {
+		enc[code] = fmt.Sprintf("/uni%04X", r)
+	}
+
+	enc[0x20] = "/space"
+	for c := 0x21; c <= 0x7E; c++ {
+		set(c, rune(0x2700+c-0x20))
+	}
+	for c := 0x80; c <= 0x8D; c++ {
+		set(c, rune(0x2768+c-0x80))
+	}
+	for c := 0xA1; c <= 0xFE; c++ {
+		if c != 0xF0 {
+			set(c, rune(0x2700+c-0x40))
+		}
+	}
+	for c := 0xAC; c <= 0xB5; c++ {
+		set(c, rune(0x2460+c-0xAC)) // Circled digits one to ten
+	}
+
+	exceptions := map[int]rune{
+		0x25: 0x260E, 0x2A: 0x261B, 0x2B: 0x261E, 0x48: 0x2605,
+		0x6C: 0x25CF, 0x6E: 0x25A0, 0x73: 0x25B2, 0x74: 0x25BC,
+		0x75: 0x25C6, 0x77: 0x25D7, 0xA8: 0x2663, 0xA9: 0x2666,
+		0xAA: 0x2665, 0xAB: 0x2660, 0xD5: 0x2192, 0xD6: 0x2194,
+		0xD7: 0x2195,
+	}
+	for c, r := range exceptions {
+		set(c, r)
+	}
+	return enc
+}()
+
+// namedEncoding returns the table for an /Encoding or /BaseEncoding name,
+// or nil if the name is not a standard encoding.
+func namedEncoding(name string) *[256]string {
+	switch name {
+	case "/StandardEncoding":
+		return &standardEncoding
+	case "/WinAnsiEncoding":
+		return &winAnsiEncoding
+	case "/MacRomanEncoding":
+		return &macRomanEncoding
+	case "/PDFDocEncoding":
+		return &pdfDocEncoding
+	case "/SymbolEncoding":
+		return &symbolEncoding
+	case "/ZapfDingbatsEncoding":
+		return &zapfDingbatsEncoding
+	}
+	return nil
+}
+
+// builtinEncoding returns the built-in encoding of a standard 14 font whose
+// encoding is not StandardEncoding (Symbol and ZapfDingbats), or nil.
+func builtinEncoding(baseFont string) *[256]string {
+	name := stripSubsetPrefix(strings.TrimPrefix(baseFont, "/"))
+	switch {
+	case strings.HasPrefix(name, "Symbol"):
+		return &symbolEncoding
+	case strings.HasPrefix(name, "ZapfDingbats"), strings.HasPrefix(name, "Dingbats"):
+		return &zapfDingbatsEncoding
+	}
+	return nil
+}
+
+// stripSubsetPrefix removes the "ABCDEF+" tag that subsetting tools prepend
+// to font names.
+func stripSubsetPrefix(name string) string {
+	if len(name) > 7 && name[6] == '+' {
+		for i := 0; i < 6; i++ {
+			if name[i] < 'A' || name[i] > 'Z' {
+				return name
+			}
+		}
+		return name[7:]
+	}
+	return name
+}
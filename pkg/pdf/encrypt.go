@@ -1,35 +1,95 @@
 package pdf
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/md5"
 	"crypto/rc4"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
 	"errors"
 	"fmt"
 )
 
+// ErrWrongPassword is returned when the supplied password matches neither the
+// user password nor the owner password of an encrypted document.
+var ErrWrongPassword = errors.New("pdf: incorrect password")
+
 // EncryptDict represents the PDF encryption dictionary
 type EncryptDict struct {
 	Filter          string // Should be "/Standard"
-	V               int    // Version: 1, 2, 4
-	R               int    // Revision: 2, 3, 4
-	O               []byte // Owner password hash (48 bytes)
-	U               []byte // User password hash (48 bytes)
+	V               int    // Version: 1, 2, 4, 5
+	R               int    // Revision: 2, 3, 4, 5, 6
+	O               []byte // Owner password hash (32 or 48 bytes)
+	U               []byte // User password hash (32 or 48 bytes)
+	OE              []byte // Owner-encrypted file key (R5/R6 only, 32 bytes)
+	UE              []byte // User-encrypted file key (R5/R6 only, 32 bytes)
+	Perms           []byte // Encrypted permissions (R5/R6 only, 16 bytes)
 	P               int32  // Permission flags
-	Length          int    // Key length in bits (40, 128)
+	Length          int    // Key length in bits (40, 128, 256)
 	EncryptMetadata bool   // Usually true
+
+	// Crypt filters (V4/V5 only)
+	CF   map[string]CryptFilter // Named crypt filters from /CF
+	StmF string                 // Filter for streams (default /Identity)
+	StrF string                 // Filter for strings (default /Identity)
+	EFF  string                 // Filter for embedded files (default StmF)
+}
+
+// CryptFilter represents an entry of the /CF dictionary
+type CryptFilter struct {
+	CFM       string // Method: /None, /V2 (RC4), /AESV2, /AESV3
+	AuthEvent string // /DocOpen or /EFOpen
+	Length    int    // Key length in bytes
+}
+
+// cryptMethod is the cipher selected for a given string or stream
+type cryptMethod int
+
+const (
+	cryptIdentity cryptMethod = iota // Not encrypted
+	cryptRC4                         // RC4 with per-object key
+	cryptAESV2                       // AES-128 with per-object key
+	cryptAESV3                       // AES-256 with the file key
+)
+
+func (m cryptMethod) String() string {
+	switch m {
+	case cryptRC4:
+		return "RC4"
+	case cryptAESV2:
+		return "AES-128"
+	case cryptAESV3:
+		return "AES-256"
+	}
+	return "Identity"
 }
 
 // EncryptionHandler handles PDF encryption/decryption
 type EncryptionHandler struct {
-	Dict       *EncryptDict
-	FileID     []byte // From trailer /ID
-	EncryptKey []byte // Computed encryption key
-	V          int    // Algorithm version
-	R          int    // Standard security handler revision
+	Dict        *EncryptDict
+	FileID      []byte // From trailer /ID
+	EncryptKey  []byte // Computed encryption key
+	V           int    // Algorithm version
+	R           int    // Standard security handler revision
+	OwnerAccess bool   // True if authenticated with the owner password
 }
 
+// Permission flags of the /P entry (Table 22 of the PDF spec).
+// Bit positions are 1-based in the spec, hence the shift by one less.
+const (
+	PermPrint            = 1 << 2  // Bit 3
+	PermModify           = 1 << 3  // Bit 4
+	PermCopyExtract      = 1 << 4  // Bit 5
+	PermAnnotate         = 1 << 5  // Bit 6
+	PermFillForms        = 1 << 8  // Bit 9 (R3+)
+	PermAccessibility    = 1 << 9  // Bit 10 (R3+)
+	PermAssemble         = 1 << 10 // Bit 11 (R3+)
+	PermPrintHighQuality = 1 << 11 // Bit 12 (R3+)
+)
+
 // PDF standard padding string (32 bytes) - from PDF spec
 var paddingString = []byte{
 	0x28, 0xBF, 0x4E, 0x5E, 0x4E, 0x75, 0x8A, 0x41,
@@ -115,16 +175,127 @@ func ParseEncryptDict(obj Object, reader *Reader) (*EncryptDict, error) {
 		}
 	}
 
+	// AES-256 (R5/R6) wraps the file key in /OE and /UE and
+	// stores a copy of the permissions in /Perms
+	if encDict.R >= 5 {
+		encDict.Length = 256
+		encDict.OE = stringBytes(reader.Resolve(dict["/OE"]))
+		encDict.UE = stringBytes(reader.Resolve(dict["/UE"]))
+		encDict.Perms = stringBytes(reader.Resolve(dict["/Perms"]))
+		if len(encDict.O) < 48 || len(encDict.U) < 48 {
+			return nil, errors.New("invalid /O or /U in AES-256 encryption dictionary")
+		}
+		if len(encDict.OE) < 32 || len(encDict.UE) < 32 {
+			return nil, errors.New("missing or invalid /OE or /UE in encryption dictionary")
+		}
+	}
+
 	// Extract EncryptMetadata (optional, default true)
 	if em, ok := dict["/EncryptMetadata"].(BooleanObject); ok {
 		encDict.EncryptMetadata = bool(em)
 	}
 
+	// Extract crypt filters (V4 and later)
+	if encDict.V >= 4 {
+		encDict.CF = make(map[string]CryptFilter)
+		encDict.StmF = "/Identity"
+		encDict.StrF = "/Identity"
+
+		if cf, ok := reader.Resolve(dict["/CF"]).(DictionaryObject); ok {
+			for name, val := range cf {
+				if filterDict, ok := reader.Resolve(val).(DictionaryObject); ok {
+					encDict.CF[name] = parseCryptFilter(filterDict)
+				}
+			}
+		}
+		if stmF, ok := dict["/StmF"].(NameObject); ok {
+			encDict.StmF = string(stmF)
+		}
+		if strF, ok := dict["/StrF"].(NameObject); ok {
+			encDict.StrF = string(strF)
+		}
+		encDict.EFF = encDict.StmF
+		if eff, ok := dict["/EFF"].(NameObject); ok {
+			encDict.EFF = string(eff)
+		}
+
+		// V4 files may give the key length only on the crypt filter
+		if _, ok := dict["/Length"]; !ok && encDict.V == 4 {
+			if cf, ok := encDict.CF[encDict.StmF]; ok && cf.Length > 0 {
+				encDict.Length = cf.Length * 8
+			}
+		}
+	}
+
 	return encDict, nil
 }
 
+// parseCryptFilter extracts a single crypt filter dictionary
+func parseCryptFilter(dict DictionaryObject) CryptFilter {
+	cf := CryptFilter{
+		CFM:       "/None",
+		AuthEvent: "/DocOpen",
+	}
+	if cfm, ok := dict["/CFM"].(NameObject); ok {
+		cf.CFM = string(cfm)
+	}
+	if ae, ok := dict["/AuthEvent"].(NameObject); ok {
+		cf.AuthEvent = string(ae)
+	}
+	if length, ok := dict["/Length"].(NumberObject); ok {
+		cf.Length = int(length)
+		// Some writers give the length in bits rather than bytes
+		if cf.Length > 32 {
+			cf.Length /= 8
+		}
+	}
+	return cf
+}
+
+// HasPermission reports whether the /P entry grants the given flag.
+// Revision 2 has no bits above 6, so those fall back to their R2 equivalents.
+func (d *EncryptDict) HasPermission(flag int32) bool {
+	if d.R == 2 {
+		switch flag {
+		case PermFillForms:
+			flag = PermAnnotate
+		case PermAccessibility:
+			flag = PermCopyExtract
+		case PermAssemble:
+			flag = PermModify
+		case PermPrintHighQuality:
+			flag = PermPrint
+		}
+	}
+	return d.P&flag != 0
+}
+
+// Algorithm returns the cipher used for streams, e.g. "AES-128"
+func (h *EncryptionHandler) Algorithm() string {
+	return h.methodFor(h.Dict.StmF).String()
+}
+
+// stringBytes returns the raw bytes of a literal or hex string, or nil
+func stringBytes(obj Object) []byte {
+	switch v := obj.(type) {
+	case StringObject:
+		return []byte(v)
+	case HexStringObject:
+		return []byte(v)
+	}
+	return nil
+}
+
 // NewEncryptionHandler creates a new encryption handler with empty password
 func NewEncryptionHandler(encDict *EncryptDict, fileID []byte) (*EncryptionHandler, error) {
+	return NewEncryptionHandlerWithPassword(encDict, fileID, nil)
+}
+
+// NewEncryptionHandlerWithPassword creates an encryption handler and
+// authenticates the given password, first as the user password (Algorithm 6)
+// and then as the owner password (Algorithm 7).
+// Returns ErrWrongPassword if neither check succeeds.
+func NewEncryptionHandlerWithPassword(encDict *EncryptDict, fileID []byte, password []byte) (*EncryptionHandler, error) {
 	if encDict == nil {
 		return nil, errors.New("encryption dictionary is nil")
 	}
@@ -136,10 +307,28 @@ func NewEncryptionHandler(encDict *EncryptDict, fileID []byte) (*EncryptionHandl
 		R:      encDict.R,
 	}
 
-	// Compute encryption key with empty password (for owner-password-only PDFs)
-	handler.EncryptKey = handler.computeEncryptionKey([]byte{})
+	// AES-256 uses the SHA-2 based algorithms instead
+	if encDict.R >= 5 {
+		if err := handler.authenticateAES256(password); err != nil {
+			return nil, err
+		}
+		return handler, nil
+	}
 
-	return handler, nil
+	// 1. Try the password as the user password
+	if key, ok := handler.authenticateUserPassword(password); ok {
+		handler.EncryptKey = key
+		return handler, nil
+	}
+
+	// 2. Try the password as the owner password
+	if key, ok := handler.authenticateOwnerPassword(password); ok {
+		handler.EncryptKey = key
+		handler.OwnerAccess = true
+		return handler, nil
+	}
+
+	return nil, ErrWrongPassword
 }
 
 // padPassword pads or truncates password to 32 bytes using PDF standard padding
@@ -193,8 +382,8 @@ func (h *EncryptionHandler) computeEncryptionKey(password []byte) []byte {
 	digest := hash.Sum(nil)
 
 	// 6. If R >= 3, do 50 additional MD5 iterations
+	keyLen := h.keyLength()
 	if h.R >= 3 {
-		keyLen := h.Dict.Length / 8
 		for i := 0; i < 50; i++ {
 			hash := md5.New()
 			hash.Write(digest[:keyLen])
@@ -203,13 +392,132 @@ func (h *EncryptionHandler) computeEncryptionKey(password []byte) []byte {
 	}
 
 	// 7. Return first n bytes (n = Length/8)
-	keyLen := h.Dict.Length / 8
 	return digest[:keyLen]
 }
 
+// keyLength returns the file key length in bytes, clamped to the range
+// allowed for the MD5-based algorithms (40 to 128 bits)
+func (h *EncryptionHandler) keyLength() int {
+	if h.R == 2 {
+		return 5
+	}
+	keyLen := h.Dict.Length / 8
+	if keyLen < 5 {
+		keyLen = 5
+	}
+	if keyLen > 16 {
+		keyLen = 16
+	}
+	return keyLen
+}
+
+// computeUserHash implements Algorithms 4 and 5 from PDF spec
+// Computes the expected /U value for a given file encryption key
+func (h *EncryptionHandler) computeUserHash(key []byte) []byte {
+	if h.R == 2 {
+		// Algorithm 4: RC4-encrypt the padding string with the file key
+		c, _ := rc4.NewCipher(key)
+		out := make([]byte, 32)
+		c.XORKeyStream(out, paddingString)
+		return out
+	}
+
+	// Algorithm 5: MD5(padding + ID), then 20 rounds of RC4
+	hash := md5.New()
+	hash.Write(paddingString)
+	hash.Write(h.FileID)
+	out := hash.Sum(nil)
+
+	out = rc4Rounds(key, out, 0, 19)
+
+	// The remaining 16 bytes are arbitrary padding
+	return out
+}
+
+// rc4Rounds encrypts data with RC4 once per round from `from` to `to`
+// (inclusive), XORing every byte of the key with the round number.
+// Used by Algorithms 5 and 7, which run in opposite directions.
+func rc4Rounds(key, data []byte, from, to int) []byte {
+	out := make([]byte, len(data))
+	copy(out, data)
+
+	roundKey := make([]byte, len(key))
+	step := 1
+	if from > to {
+		step = -1
+	}
+	for i := from; ; i += step {
+		for j := range key {
+			roundKey[j] = key[j] ^ byte(i)
+		}
+		c, _ := rc4.NewCipher(roundKey)
+		c.XORKeyStream(out, out)
+		if i == to {
+			break
+		}
+	}
+	return out
+}
+
+// authenticateUserPassword implements Algorithm 6 from PDF spec
+// Returns the file encryption key if password is the user password
+func (h *EncryptionHandler) authenticateUserPassword(password []byte) ([]byte, bool) {
+	key := h.computeEncryptionKey(password)
+	expected := h.computeUserHash(key)
+
+	// R2 compares all 32 bytes; R3+ only the first 16
+	n := 32
+	if h.R >= 3 {
+		n = 16
+	}
+	if len(h.Dict.U) < n || len(expected) < n {
+		return nil, false
+	}
+	if !bytes.Equal(expected[:n], h.Dict.U[:n]) {
+		return nil, false
+	}
+	return key, true
+}
+
+// authenticateOwnerPassword implements Algorithm 7 from PDF spec
+// Recovers the user password from /O and authenticates it
+func (h *EncryptionHandler) authenticateOwnerPassword(password []byte) ([]byte, bool) {
+	if len(h.Dict.O) < 32 {
+		return nil, false
+	}
+
+	// 1. Compute the RC4 key from the owner password (Algorithm 3, steps a-d)
+	digest := md5.Sum(padPassword(password))
+	keyLen := h.keyLength()
+	if h.R >= 3 {
+		// Unlike Algorithm 2, each round rehashes the full digest
+		for i := 0; i < 50; i++ {
+			digest = md5.Sum(digest[:])
+		}
+	}
+	rc4Key := digest[:keyLen]
+
+	// 2. Decrypt /O to recover the padded user password
+	var userPassword []byte
+	if h.R == 2 {
+		c, _ := rc4.NewCipher(rc4Key)
+		userPassword = make([]byte, 32)
+		c.XORKeyStream(userPassword, h.Dict.O[:32])
+	} else {
+		userPassword = rc4Rounds(rc4Key, h.Dict.O[:32], 19, 0)
+	}
+
+	return h.authenticateUserPassword(userPassword)
+}
+
 // computeObjectKey implements Algorithm 1 from PDF spec
 // Derives per-object encryption key from file encryption key
-func (h *EncryptionHandler) computeObjectKey(objNum, genNum int) []byte {
+func (h *EncryptionHandler) computeObjectKey(objNum, genNum int, useAES bool) []byte {
+	// AES-256 uses the file key directly, without per-object derivation
+	if h.V >= 5 {
+		return h.EncryptKey
+	}
+
 	// Start with file encryption key
 	keyLen := len(h.EncryptKey)
 	key := make([]byte, keyLen+5)
@@ -224,8 +532,8 @@ func (h *EncryptionHandler) computeObjectKey(objNum, genNum int) []byte {
 	key[keyLen+3] = byte(genNum)
 	key[keyLen+4] = byte(genNum >> 8)
 
-	// If AES (V >= 4), add salt "sAlT"
-	if h.V >= 4 {
+	// If AES, add salt "sAlT"
+	if useAES {
 		key = append(key, 0x73, 0x41, 0x6C, 0x54)
 	}
 
@@ -240,13 +548,129 @@ func (h *EncryptionHandler) computeObjectKey(objNum, genNum int) []byte {
 	return hash[:n]
 }
 
+// authenticateAES256 implements Algorithm 2.A from ISO 32000-2
+// Validates the password against /U and then /O, unwraps the file key
+// from /UE or /OE, and checks it against /Perms.
+func (h *EncryptionHandler) authenticateAES256(password []byte) error {
+	// Passwords are UTF-8, truncated to 127 bytes
+	// (SASLprep normalisation is not applied)
+	if len(password) > 127 {
+		password = password[:127]
+	}
+
+	u := h.Dict.U[:48]
+	o := h.Dict.O[:48]
+
+	var intermediate, wrapped []byte
+	switch {
+	case bytes.Equal(h.hashR6(password, u[32:40], nil), u[:32]):
+		// User password: key salt is U[40:48], no extra data
+		intermediate = h.hashR6(password, u[40:48], nil)
+		wrapped = h.Dict.UE[:32]
+	case bytes.Equal(h.hashR6(password, o[32:40], u), o[:32]):
+		// Owner password: key salt is O[40:48], extra data is the full /U
+		intermediate = h.hashR6(password, o[40:48], u)
+		wrapped = h.Dict.OE[:32]
+		h.OwnerAccess = true
+	default:
+		return ErrWrongPassword
+	}
+
+	// Unwrap the file key: AES-256, CBC, zero IV, no padding
+	block, err := aes.NewCipher(intermediate)
+	if err != nil {
+		return fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+	key := make([]byte, 32)
+	cipher.NewCBCDecrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(key, wrapped)
+	h.EncryptKey = key
+
+	return h.validatePerms()
+}
+
+// hashR6 implements Algorithm 2.B from ISO 32000-2
+// R5 uses a single SHA-256; R6 adds the iterated SHA-256/384/512 rounds.
+func (h *EncryptionHandler) hashR6(password, salt, udata []byte) []byte {
+	sum := sha256.New()
+	sum.Write(password)
+	sum.Write(salt)
+	sum.Write(udata)
+	k := sum.Sum(nil)
+
+	if h.R < 6 {
+		return k
+	}
+
+	for round := 0; ; round++ {
+		// K1 = 64 repetitions of (password + K + udata)
+		seq := make([]byte, 0, len(password)+len(k)+len(udata))
+		seq = append(seq, password...)
+		seq = append(seq, k...)
+		seq = append(seq, udata...)
+		k1 := bytes.Repeat(seq, 64)
+
+		// E = AES-128-CBC(key=K[0:16], iv=K[16:32], K1), no padding
+		block, _ := aes.NewCipher(k[:16])
+		e := make([]byte, len(k1))
+		cipher.NewCBCEncrypter(block, k[16:32]).CryptBlocks(e, k1)
+
+		// First 16 bytes of E as a big-endian number mod 3 selects the hash.
+		// Since 256 mod 3 == 1, this equals the byte sum mod 3.
+		mod := 0
+		for _, b := range e[:16] {
+			mod += int(b)
+		}
+		switch mod % 3 {
+		case 0:
+			d := sha256.Sum256(e)
+			k = d[:]
+		case 1:
+			d := sha512.Sum384(e)
+			k = d[:]
+		default:
+			d := sha512.Sum512(e)
+			k = d[:]
+		}
+
+		// At least 64 rounds, then stop once the last byte of E is small enough
+		if round >= 63 && int(e[len(e)-1]) <= round+1-32 {
+			break
+		}
+	}
+	return k[:32]
+}
+
+// validatePerms implements Algorithm 13 from ISO 32000-2
+// Decrypts /Perms with the file key and checks it against /P.
+func (h *EncryptionHandler) validatePerms() error {
+	if len(h.Dict.Perms) < 16 {
+		// /Perms is required for R6, but tolerate its absence
+		return nil
+	}
+
+	block, err := aes.NewCipher(h.EncryptKey)
+	if err != nil {
+		return fmt.Errorf("failed to create AES cipher: %w", err)
+	}
+	perms := make([]byte, 16)
+	block.Decrypt(perms, h.Dict.Perms[:16]) // ECB: a single block
+
+	if string(perms[9:12]) != "adb" {
+		return errors.New("invalid /Perms entry: file key does not match")
+	}
+	if int32(binary.LittleEndian.Uint32(perms[:4])) != h.Dict.P {
+		return errors.New("invalid /Perms entry: permissions do not match /P")
+	}
+	return nil
+}
+
 // decryptRC4 decrypts data using RC4 algorithm
 func (h *EncryptionHandler) decryptRC4(data []byte, objNum, genNum int) ([]byte, error) {
 	if len(data) == 0 {
 		return data, nil
 	}
 
-	key := h.computeObjectKey(objNum, genNum)
+	key := h.computeObjectKey(objNum, genNum, false)
 
 	cipher, err := rc4.NewCipher(key)
 	if err != nil {
@@ -284,18 +708,21 @@ func removePadding(data []byte) ([]byte, error) {
 	return data[:len(data)-paddingLen], nil
 }
 
-// decryptAES decrypts data using AES-128 in CBC mode
+// decryptAES decrypts data using AES in CBC mode (AES-128, or AES-256 for V5)
 func (h *EncryptionHandler) decryptAES(data []byte, objNum, genNum int) ([]byte, error) {
 	if len(data) < 16 {
 		return nil, fmt.Errorf("encrypted data too short for AES (need at least 16 bytes for IV, got %d)", len(data))
 	}
 
-	key := h.computeObjectKey(objNum, genNum)
+	key := h.computeObjectKey(objNum, genNum, true)
 
 	// First 16 bytes are IV (initialization vector)
 	iv := data[:16]
 	ciphertext := data[16:]
 
+	// Ignore trailing garbage that does not fill a whole block
+	ciphertext = ciphertext[:len(ciphertext)-len(ciphertext)%aes.BlockSize]
+
 	// Create AES cipher
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -312,20 +739,109 @@ func (h *EncryptionHandler) decryptAES(data []byte, objNum, genNum int) ([]byte,
 	return removePadding(decrypted)
 }
 
-// Decrypt decrypts data for a specific object using appropriate algorithm
+// Decrypt decrypts data for a specific object using the document's
+// default stream method
 func (h *EncryptionHandler) Decrypt(data []byte, objNum, genNum int) ([]byte, error) {
+	return h.decryptWith(h.methodFor(h.Dict.StmF), data, objNum, genNum)
+}
+
+// DecryptString decrypts a string object using the /StrF crypt filter
+func (h *EncryptionHandler) DecryptString(data []byte, objNum, genNum int) ([]byte, error) {
+	return h.decryptWith(h.methodFor(h.Dict.StrF), data, objNum, genNum)
+}
+
+// DecryptStream decrypts stream data, selecting the crypt filter from the
+// stream dictionary: a /Crypt entry in /Filter overrides the default,
+// embedded files use /EFF, and all other streams use /StmF.
+func (h *EncryptionHandler) DecryptStream(data []byte, objNum, genNum int, dict DictionaryObject) ([]byte, error) {
+	return h.decryptWith(h.streamMethod(dict), data, objNum, genNum)
+}
+
+// streamMethod selects the crypt method for a stream dictionary
+func (h *EncryptionHandler) streamMethod(dict DictionaryObject) cryptMethod {
+	// 1. Explicit /Crypt filter (must be the first filter in the chain)
+	var first NameObject
+	var parms DictionaryObject
+	switch f := dict["/Filter"].(type) {
+	case NameObject:
+		first = f
+		parms, _ = dict["/DecodeParms"].(DictionaryObject)
+	case ArrayObject:
+		if len(f) > 0 {
+			first, _ = f[0].(NameObject)
+		}
+		if arr, ok := dict["/DecodeParms"].(ArrayObject); ok && len(arr) > 0 {
+			parms, _ = arr[0].(DictionaryObject)
+		}
+	}
+	if first == "/Crypt" {
+		name := "/Identity"
+		if n, ok := parms["/Name"].(NameObject); ok {
+			name = string(n)
+		}
+		return h.methodFor(name)
+	}
+
+	// 2. Metadata streams stay in the clear when /EncryptMetadata is false
+	streamType, _ := dict["/Type"].(NameObject)
+	if streamType == "/Metadata" && !h.Dict.EncryptMetadata {
+		return cryptIdentity
+	}
+
+	// 3. Embedded files
+	if streamType == "/EmbeddedFile" {
+		return h.methodFor(h.Dict.EFF)
+	}
+
+	return h.methodFor(h.Dict.StmF)
+}
+
+// methodFor resolves a crypt filter name to a cipher
+func (h *EncryptionHandler) methodFor(name string) cryptMethod {
+	// Before V4 everything is RC4
+	if h.V < 4 {
+		return cryptRC4
+	}
+	if name == "/Identity" {
+		return cryptIdentity
+	}
+
+	if cf, ok := h.Dict.CF[name]; ok {
+		switch cf.CFM {
+		case "/V2":
+			return cryptRC4
+		case "/AESV2":
+			return cryptAESV2
+		case "/AESV3":
+			return cryptAESV3
+		case "/None":
+			return cryptIdentity
+		}
+	}
+
+	// Unknown filter: assume the default cipher for this version
+	if h.V >= 5 {
+		return cryptAESV3
+	}
+	return cryptAESV2
+}
+
+// decryptWith decrypts data with the given method
+func (h *EncryptionHandler) decryptWith(method cryptMethod, data []byte, objNum, genNum int) ([]byte, error) {
 	if len(data) == 0 {
 		return data, nil
 	}
 
-	switch h.V {
-	case 1, 2:
+	switch method {
+	case cryptIdentity:
+		return data, nil
+	case cryptRC4:
 		// RC4 encryption (40-bit or 128-bit)
 		return h.decryptRC4(data, objNum, genNum)
-	case 4:
-		// AES-128 encryption
+	case cryptAESV2, cryptAESV3:
+		// AES-128 (V4) or AES-256 (V5) encryption
 		return h.decryptAES(data, objNum, genNum)
 	default:
-		return nil, fmt.Errorf("unsupported encryption version: %d (only V1, V2, V4 are supported)", h.V)
+		return nil, fmt.Errorf("unsupported crypt method: %d", method)
 	}
 }
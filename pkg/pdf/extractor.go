@@ -1,9 +1,12 @@
 package pdf
 
 import (
+	"errors"
 	"io"
 	"math"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/AOShei/go-fast-pdf/pkg/model"
 )
@@ -11,257 +14,6 @@ import (
 // Matrix is a 3x3 transform matrix (last row implicitly 0,0,1).
 type Matrix [6]float64
 
-// glyphToUnicode maps PostScript glyph names to Unicode characters
-var glyphToUnicode = map[string]string{
-	"/space":        " ",
-	"/exclam":       "!",
-	"/quotedbl":     "\"",
-	"/numbersign":   "#",
-	"/dollar":       "$",
-	"/percent":      "%",
-	"/ampersand":    "&",
-	"/quoteright":   "'",
-	"/quotesingle":  "'",
-	"/parenleft":    "(",
-	"/parenright":   ")",
-	"/asterisk":     "*",
-	"/plus":         "+",
-	"/comma":        ",",
-	"/hyphen":       "-",
-	"/period":       ".",
-	"/slash":        "/",
-	"/zero":         "0",
-	"/one":          "1",
-	"/two":          "2",
-	"/three":        "3",
-	"/four":         "4",
-	"/five":         "5",
-	"/six":          "6",
-	"/seven":        "7",
-	"/eight":        "8",
-	"/nine":         "9",
-	"/colon":        ":",
-	"/semicolon":    ";",
-	"/less":         "<",
-	"/equal":        "=",
-	"/greater":      ">",
-	"/question":     "?",
-	"/at":           "@",
-	"/A":            "A",
-	"/B":            "B",
-	"/C":            "C",
-	"/D":            "D",
-	"/E":            "E",
-	"/F":            "F",
-	"/G":            "G",
-	"/H":            "H",
-	"/I":            "I",
-	"/J":            "J",
-	"/K":            "K",
-	"/L":            "L",
-	"/M":            "M",
-	"/N":            "N",
-	"/O":            "O",
-	"/P":            "P",
-	"/Q":            "Q",
-	"/R":            "R",
-	"/S":            "S",
-	"/T":            "T",
-	"/U":            "U",
-	"/V":            "V",
-	"/W":            "W",
-	"/X":            "X",
-	"/Y":            "Y",
-	"/Z":            "Z",
-	"/bracketleft":  "[",
-	"/backslash":    "\\",
-	"/bracketright": "]",
-	"/asciicircum":  "^",
-	"/underscore":   "_",
-	"/grave":        "`",
-	"/quoteleft":    "`",
-	"/a":            "a",
-	"/b":            "b",
-	"/c":            "c",
-	"/d":            "d",
-	"/e":            "e",
-	"/f":            "f",
-	"/g":            "g",
-	"/h":            "h",
-	"/i":            "i",
-	"/j":            "j",
-	"/k":            "k",
-	"/l":            "l",
-	"/m":            "m",
-	"/n":            "n",
-	"/o":            "o",
-	"/p":            "p",
-	"/q":            "q",
-	"/r":            "r",
-	"/s":            "s",
-	"/t":            "t",
-	"/u":            "u",
-	"/v":            "v",
-	"/w":            "w",
-	"/x":            "x",
-	"/y":            "y",
-	"/z":            "z",
-	"/braceleft":    "{",
-	"/bar":          "|",
-	"/braceright":   "}",
-	"/asciitilde":   "~",
-
-	// Ligatures
-	"/fi":  "fi",
-	"/fl":  "fl",
-	"/ff":  "ff",
-	"/ffi": "ffi",
-	"/ffl": "ffl",
-	"/st":  "st",
-	"/ct":  "ct",
-	"/IJ":  "IJ",
-	"/ij":  "ij",
-
-	// Extended Latin characters
-	"/AE":       "Æ",
-	"/ae":       "æ",
-	"/OE":       "Œ",
-	"/oe":       "œ",
-	"/oslash":   "ø",
-	"/Oslash":   "Ø",
-	"/lslash":   "ł",
-	"/Lslash":   "Ł",
-	"/Eth":      "Ð",
-	"/eth":      "ð",
-	"/Thorn":    "Þ",
-	"/thorn":    "þ",
-	"/ssharp":   "ß",
-	"/Scaron":   "Š",
-	"/scaron":   "š",
-	"/Zcaron":   "Ž",
-	"/zcaron":   "ž",
-	"/Ccedilla": "Ç",
-	"/ccedilla": "ç",
-
-	// Mathematical operators
-	"/minus":        "−", // U+2212 math minus (not hyphen)
-	"/multiply":     "×",
-	"/divide":       "÷",
-	"/notequal":     "≠",
-	"/lessequal":    "≤",
-	"/greaterequal": "≥",
-	"/approxequal":  "≈",
-	"/infinity":     "∞",
-	"/integral":     "∫",
-	"/product":      "∏",
-	"/summation":    "∑",
-	"/radical":      "√",
-	"/partialdiff":  "∂",
-	"/plusminus":    "±",
-	"/therefore":    "∴",
-	"/proportional": "∝",
-	"/angle":        "∠",
-	"/logicaland":   "∧",
-	"/logicalor":    "∨",
-	"/intersection": "∩",
-	"/union":        "∪",
-
-	// Greek letters (common in math/science)
-	"/Alpha":   "Α",
-	"/Beta":    "Β",
-	"/Gamma":   "Γ",
-	"/Delta":   "Δ",
-	"/Epsilon": "Ε",
-	"/Zeta":    "Ζ",
-	"/Eta":     "Η",
-	"/Theta":   "Θ",
-	"/Iota":    "Ι",
-	"/Kappa":   "Κ",
-	"/Lambda":  "Λ",
-	"/Mu":      "Μ",
-	"/Nu":      "Ν",
-	"/Xi":      "Ξ",
-	"/Omicron": "Ο",
-	"/Pi":      "Π",
-	"/Rho":     "Ρ",
-	"/Sigma":   "Σ",
-	"/Tau":     "Τ",
-	"/Upsilon": "Υ",
-	"/Phi":     "Φ",
-	"/Chi":     "Χ",
-	"/Psi":     "Ψ",
-	"/Omega":   "Ω",
-	"/alpha":   "α",
-	"/beta":    "β",
-	"/gamma":   "γ",
-	"/delta":   "δ",
-	"/epsilon": "ε",
-	"/zeta":    "ζ",
-	"/eta":     "η",
-	"/theta":   "θ",
-	"/iota":    "ι",
-	"/kappa":   "κ",
-	"/lambda":  "λ",
-	"/mu":      "μ",
-	"/nu":      "ν",
-	"/xi":      "ξ",
-	"/omicron": "ο",
-	"/pi":      "π",
-	"/rho":     "ρ",
-	"/sigma":   "σ",
-	"/tau":     "τ",
-	"/upsilon": "υ",
-	"/phi":     "φ",
-	"/chi":     "χ",
-	"/psi":     "ψ",
-	"/omega":   "ω",
-
-	// Astronomy/Physics symbols
-	"/circledot": "⊙", // Solar mass symbol
-	"/sun":       "☉",
-	"/venus":     "♀",
-	"/earth":     "♁",
-	"/mars":      "♂",
-	"/jupiter":   "♃",
-	"/saturn":    "♄",
-	"/uranus":    "♅",
-	"/neptune":   "♆",
-	"/pluto":     "♇",
-
-	// Superscripts
-	"/zero.superior":  "⁰",
-	"/one.superior":   "¹",
-	"/two.superior":   "²",
-	"/three.superior": "³",
-	"/four.superior":  "⁴",
-	"/five.superior":  "⁵",
-	"/six.superior":   "⁶",
-	"/seven.superior": "⁷",
-	"/eight.superior": "⁸",
-	"/nine.superior":  "⁹",
-	"/plus.superior":  "⁺",
-	"/minus.superior": "⁻",
-
-	// Subscripts
-	"/zero.inferior":  "₀",
-	"/one.inferior":   "₁",
-	"/two.inferior":   "₂",
-	"/three.inferior": "₃",
-	"/four.inferior":  "₄",
-	"/five.inferior":  "₅",
-	"/six.inferior":   "₆",
-	"/seven.inferior": "₇",
-	"/eight.inferior": "₈",
-	"/nine.inferior":  "₉",
-	"/plus.inferior":  "₊",
-	"/minus.inferior": "₋",
-
-	// Zero-width characters
-	"/zerowidthspace":     "\u200B",
-	"/zerowidthnonjoiner": "\u200C",
-	"/zerowidthjoiner":    "\u200D",
-}
-
 // isPrintableASCII returns true if byte is printable ASCII
 func isPrintableASCII(b byte) bool {
 	return b >= 0x20 && b <= 0x7E
@@ -303,20 +55,81 @@ func (a Matrix) Mult(b Matrix) Matrix {
 	}
 }
 
-// GraphicsState tracks global graphics parameters (CTM).
+// GraphicsState tracks global graphics parameters (CTM, fill color).
 type GraphicsState struct {
-	CTM Matrix // Current Transformation Matrix
+	CTM            Matrix    // Current Transformation Matrix
+	FillColorSpace string    // e.g. "/DeviceGray"
+	FillColor      []float64 // Components in FillColorSpace
 }
 
 // Font represents a PDF font with metrics and mapping.
 type Font struct {
-	BaseFont   string
-	CMap       *CMap
-	Encoding   map[int]string  // Map char code -> glyph name (from /Encoding/Differences)
-	Widths     map[int]float64 // Map char code -> width (1/1000 units)
-	MissingW   float64         // Default width
-	SpaceWidth float64         // Width of a space character
-	IsCID      bool
+	BaseFont     string
+	Subtype      string // /Type1, /TrueType, /Type0, /Type3...
+	Flags        int    // /FontDescriptor /Flags
+	CMap         *CMap
+	Encoding     map[int]string  // Map char code -> glyph name (from /Encoding/Differences)
+	BaseEncoding *[256]string    // Standard encoding the Differences were applied to
+	Widths       map[int]float64 // Map char code -> width (1/1000 units)
+	MissingW     float64         // Default width (/MissingWidth, or /DW for CID fonts)
+	SpaceWidth   float64         // Width of a space character
+	IsCID        bool            // Type0 font: multi-byte codes, Widths keyed by CID
+	FontBBox     Rectangle       // Glyph bounding box in 1/1000 text space units; zero if unknown
+
+	// CID fonts only
+	CIDEncoding    *CMap              // Encoding CMap; nil means Identity (2-byte codes, CID = code)
+	Ordering       string             // /CIDSystemInfo Registry-Ordering, e.g. Adobe-Japan1
+	Vertical       bool               // Vertical writing mode (Identity-V, /WMode 1)
+	VMetrics       map[int][3]float64 // Map CID -> [w1y vx vy] from /W2
+	DefaultVMetric [2]float64         // /DW2 [vy w1y]
+	predefined     *predefinedCMap    // Named CJK CMap (e.g. /90ms-RKSJ-H)
+	cidToGID       []uint16           // /CIDToGIDMap stream; nil means Identity
+	program        *fontProgram       // Embedded font program (/FontFile, /FontFile2, /FontFile3)
+
+	// Type3 fonts only
+	FontMatrix Matrix      // Glyph space -> text space
+	charProcs  *type3Procs // Glyph descriptions, inspected on demand
+}
+
+// fontFlagSymbolic is the Symbolic bit of /FontDescriptor /Flags (Table 121)
+const fontFlagSymbolic = 1 << 2
+
+// maxUseCMapDepth bounds usecmap chains
+const maxUseCMapDepth = 8
+
+// decodeSimpleCode maps a single-byte code through the font's encoding.
+// Codes without a usable glyph name fall back to printable ASCII.
+func (f *Font) decodeSimpleCode(b byte) string {
+	if glyphName, ok := f.Encoding[int(b)]; ok {
+		if unicode, ok := glyphNameToUnicode(glyphName); ok {
+			return unicode
+		}
+		// Made-up names can still lead to a Unicode glyph in the embedded font
+		if f.program != nil {
+			if unicode, ok := f.program.nameText(glyphName); ok {
+				return unicode
+			}
+		}
+		// Names such as "/G41" or "/c65" carry a character code instead
+		if code, ok := glyphNameCode(glyphName); ok {
+			// The name encodes its own code: prefer the base encoding's glyph
+			if code == int(b) && f.BaseEncoding != nil {
+				if unicode, ok := glyphNameToUnicode(f.BaseEncoding[b]); ok {
+					return unicode
+				}
+			}
+			return string(rune(code))
+		}
+		// Unknown glyph, try to extract character from name
+		// e.g., "/a" -> 'a'
+		if len(glyphName) == 2 && glyphName[0] == '/' && isPrintableASCII(glyphName[1]) {
+			return glyphName[1:]
+		}
+	}
+	if isPrintableASCII(b) || isWhitespaceChar(b) {
+		return string(b)
+	}
+	return ""
 }
 
 // TextState tracks text-specific parameters.
@@ -328,6 +141,7 @@ type TextState struct {
 	Scale       float64
 	Leading     float64
 	Rise        float64
+	RenderMode  int // Tr
 
 	TM  Matrix // Text Matrix
 	TLM Matrix // Text Line Matrix
@@ -345,15 +159,20 @@ func NewTextState() TextState {
 type Extractor struct {
 	reader *Reader
 	page   DictionaryObject
+	opts   ExtractorOptions
 
 	// State
 	gState    GraphicsState
 	gStack    []GraphicsState
+	gBase     int // Depth of gStack the running form may not pop below
 	textState TextState
 
 	// Resources
 	fonts map[string]*Font
 
+	// Path under construction, followed only for a RulingSink
+	path pathState
+
 	// Output
 	lastX, lastY float64
 	buffer       strings.Builder
@@ -361,47 +180,87 @@ type Extractor struct {
 	// Image tracking
 	images   *[]model.Image // Pointer allows nil (disabled) vs empty slice (enabled, no images)
 	xobjects DictionaryObject
+
+	// Form XObjects currently being drawn (by object number), against cycles
+	activeForms map[int]bool
+	formDepth   int
+
+	// Operators interpreted for the page, forms included, for MaxContentOps
+	ops      int
+	limitErr error // A limit exceeded inside a form, which stops the page
+}
+
+// maxFormDepth bounds Form XObject nesting
+const maxFormDepth = 16
+
+// ExtractorOptions configures page extraction.
+type ExtractorOptions struct {
+	// ExtractImages enables image metadata extraction.
+	ExtractImages bool
+	// UnmappedGlyph, when non-empty, is emitted for Type3 glyphs whose name
+	// yields no text and whose CharProc only paints paths or images, instead
+	// of guessing the text from the character code (e.g. "\uFFFD").
+	UnmappedGlyph string
+	// GlyphSink, when set, receives every glyph shown on the page while
+	// it is interpreted.
+	GlyphSink func(Glyph)
+	// RulingSink, when set, receives the horizontal and vertical lines
+	// that paths on the page stroke, or fill as thin rectangles.
+	RulingSink func(Ruling)
 }
 
 func NewExtractor(r *Reader, page DictionaryObject, extractImages bool) (*Extractor, error) {
+	return NewExtractorWithOptions(r, page, ExtractorOptions{ExtractImages: extractImages})
+}
+
+// NewExtractorWithOptions is NewExtractor with explicit options.
+func NewExtractorWithOptions(r *Reader, page DictionaryObject, opts ExtractorOptions) (*Extractor, error) {
 	e := &Extractor{
 		reader:    r,
 		page:      page,
-		gState:    GraphicsState{CTM: IdentityMatrix()},
+		opts:      opts,
+		gState:    GraphicsState{CTM: IdentityMatrix(), FillColorSpace: "/DeviceGray", FillColor: []float64{0}},
 		textState: NewTextState(),
 		fonts:     make(map[string]*Font),
 	}
 
 	// Only initialize images slice if extraction is enabled
-	if extractImages {
+	if opts.ExtractImages {
 		imgs := make([]model.Image, 0)
 		e.images = &imgs
 	}
 
 	// Load Fonts and XObjects from Resources
 	if res, ok := r.Resolve(page["/Resources"]).(DictionaryObject); ok {
-		if fonts, ok := r.Resolve(res["/Font"]).(DictionaryObject); ok {
-			for name, ref := range fonts {
-				var objNum int
-				// Extract Object Number if it's a reference
-				if indRef, ok := ref.(IndirectObject); ok {
-					objNum = indRef.ObjectNumber
-				}
+		e.loadResources(res)
+	}
 
-				fontObj := r.Resolve(ref).(DictionaryObject)
-				e.fonts[name] = e.loadFont(fontObj, objNum) // Pass objNum
+	return e, nil
+}
+
+// loadResources makes the fonts and XObjects of a resource dictionary current
+func (e *Extractor) loadResources(res DictionaryObject) {
+	e.fonts = make(map[string]*Font)
+	e.xobjects = nil
+
+	if fonts, ok := e.reader.Resolve(res["/Font"]).(DictionaryObject); ok {
+		for name, ref := range fonts {
+			var objNum int
+			// Extract Object Number if it's a reference
+			if indRef, ok := ref.(IndirectObject); ok {
+				objNum = indRef.ObjectNumber
 			}
-		}
 
-		// Only load XObject resources if image extraction is enabled
-		if extractImages {
-			if xobjects, ok := r.Resolve(res["/XObject"]).(DictionaryObject); ok {
-				e.xobjects = xobjects
+			if fontObj, ok := e.reader.Resolve(ref).(DictionaryObject); ok {
+				e.fonts[name] = e.loadFont(fontObj, objNum) // Pass objNum
 			}
 		}
 	}
 
-	return e, nil
+	// XObjects are needed for Form text even when image extraction is disabled
+	if xobjects, ok := e.reader.Resolve(res["/XObject"]).(DictionaryObject); ok {
+		e.xobjects = xobjects
+	}
 }
 
 // loadFont parses widths and ToUnicode maps
@@ -425,22 +284,28 @@ func (e *Extractor) loadFont(obj DictionaryObject, objNum int) *Font {
 	if bf, ok := e.reader.Resolve(obj["/BaseFont"]).(NameObject); ok {
 		f.BaseFont = string(bf)
 	}
-
-	// 4. Parse Widths (Simple Fonts)
-	// PDF defines widths for range FirstChar to LastChar
-	if firstObj, ok := e.reader.Resolve(obj["/FirstChar"]).(NumberObject); ok {
+	if st, ok := e.reader.Resolve(obj["/Subtype"]).(NameObject); ok {
+		f.Subtype = string(st)
+	}
+	e.parseFontDescriptor(f, obj["/FontDescriptor"])
+
+	// 4. Parse Widths
+	if f.Subtype == "/Type0" {
+		// Composite font: metrics live in the descendant CIDFont
+		e.loadCIDFont(f, obj)
+	} else if firstObj, ok := e.reader.Resolve(obj["/FirstChar"]).(NumberObject); ok {
+		// Simple font: PDF defines widths for range FirstChar to LastChar
 		first := int(firstObj)
 		if widths, ok := e.reader.Resolve(obj["/Widths"]).(ArrayObject); ok {
 			for i, wObj := range widths {
-				if w, ok := wObj.(NumberObject); ok {
+				if w, ok := e.reader.Resolve(wObj).(NumberObject); ok {
 					f.Widths[first+i] = float64(w)
 				}
 			}
 		}
-	} else {
-		// TODO: Handle CIDFonts (Type0) /DescendantFonts which use /W array
-		// For now, we leave Widths empty, handleText will fallback to heuristic
-		f.IsCID = true
+	}
+	if f.Subtype == "/Type3" {
+		e.loadType3(f, obj)
 	}
 
 	// 5. Determine Space Width (Try char 32, else 250 default)
@@ -452,17 +317,20 @@ func (e *Extractor) loadFont(obj DictionaryObject, objNum int) *Font {
 
 	// 6. Parse ToUnicode CMap
 	if toUnicode, ok := e.reader.Resolve(obj["/ToUnicode"]).(StreamObject); ok {
-		if cmap, err := ParseCMap(toUnicode.Data); err == nil {
+		if cmap, err := parseCMapStream(toUnicode); err == nil {
+			e.resolveUseCMap(cmap, toUnicode.Dictionary["/UseCMap"], 0)
 			f.CMap = cmap
 		} else {
 			f.CMap = NewCMap()
 		}
 	} else {
 		f.CMap = NewCMap() // Empty map, will fallback to encoding
-		// Check if there's an Encoding dictionary
-		if enc, ok := obj["/Encoding"]; ok {
-			e.parseEncoding(f, enc)
-		}
+	}
+
+	// 6b. Simple fonts: base encoding + /Differences
+	// Also used for codes missing from an incomplete ToUnicode map
+	if !f.IsCID {
+		e.parseEncoding(f, obj["/Encoding"])
 	}
 
 	// 7. Save to Global Cache (This is the missing part)
@@ -473,17 +341,34 @@ func (e *Extractor) loadFont(obj DictionaryObject, objNum int) *Font {
 	return f
 }
 
-// parseEncoding parses the /Encoding dictionary and populates the font's encoding map
+// parseEncoding parses the /Encoding entry and populates the font's encoding map
 func (e *Extractor) parseEncoding(f *Font, encObj Object) {
 	resolved := e.reader.Resolve(encObj)
 
-	// Handle NameObject (built-in encodings like /WinAnsiEncoding, /MacRomanEncoding)
-	if _, ok := resolved.(NameObject); ok {
-		// TODO: Could load standard encoding tables here
-		return
+	// 1. Pick the base encoding: a named encoding, the /BaseEncoding of an
+	// encoding dictionary, or the font's own default
+	var base *[256]string
+	switch enc := resolved.(type) {
+	case NameObject:
+		base = namedEncoding(string(enc))
+	case DictionaryObject:
+		if name, ok := e.reader.Resolve(enc["/BaseEncoding"]).(NameObject); ok {
+			base = namedEncoding(string(name))
+		}
+	}
+	if base == nil {
+		base = defaultEncoding(f)
+	}
+	f.BaseEncoding = base
+	if base != nil {
+		for code, glyphName := range base {
+			if glyphName != "" {
+				f.Encoding[code] = glyphName
+			}
+		}
 	}
 
-	// Handle DictionaryObject with /Differences array
+	// 2. Apply /Differences on top of the base encoding
 	encDict, ok := resolved.(DictionaryObject)
 	if !ok {
 		return
@@ -508,14 +393,47 @@ func (e *Extractor) parseEncoding(f *Font, encObj Object) {
 	}
 }
 
+// defaultEncoding returns the encoding used when a simple font has no
+// /Encoding (or no /BaseEncoding): Symbol and ZapfDingbats use their own,
+// other symbolic fonts rely on the font program, TrueType fonts are assumed
+// to be WinAnsi and everything else is StandardEncoding.
+func defaultEncoding(f *Font) *[256]string {
+	// Type3 glyphs exist only for the codes /Differences names
+	if f.Subtype == "/Type3" {
+		return nil
+	}
+	if enc := builtinEncoding(f.BaseFont); enc != nil {
+		return enc
+	}
+	// Embedded Type 1 and CFF programs carry their own encoding;
+	// TrueType programs only define one for symbolic fonts
+	if f.program != nil && f.program.encoding != nil && (f.Subtype != "/TrueType" || f.Flags&fontFlagSymbolic != 0) {
+		return f.program.encoding
+	}
+	if f.Flags&fontFlagSymbolic != 0 {
+		return nil
+	}
+	if f.Subtype == "/TrueType" {
+		return &winAnsiEncoding
+	}
+	return &standardEncoding
+}
+
 // ExtractText is the main entry point.
 func (e *Extractor) ExtractText() (string, error) {
-	contents := e.reader.Resolve(e.page["/Contents"])
+	contents, err := e.contentObject(e.page["/Contents"])
+	if err != nil {
+		return "", err
+	}
 	var streams []StreamObject
 
 	if arr, ok := contents.(ArrayObject); ok {
 		for _, ref := range arr {
-			if s, ok := e.reader.Resolve(ref).(StreamObject); ok {
+			obj, err := e.contentObject(ref)
+			if err != nil {
+				return "", err
+			}
+			if s, ok := obj.(StreamObject); ok {
 				streams = append(streams, s)
 			}
 		}
@@ -524,28 +442,71 @@ func (e *Extractor) ExtractText() (string, error) {
 	}
 
 	for _, stream := range streams {
-		parser := NewContentStreamParser(stream.Data)
-		for {
-			op, err := parser.Next()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				return "", err
-			}
-			e.processOp(*op)
+		data, err := stream.Data()
+		if isFatal(err) {
+			return "", err
+		} else if err != nil {
+			e.reader.warn("page content: %v", err)
+			continue
+		}
+		if err := e.runContent(data); err != nil {
+			return "", err
 		}
 	}
 
 	return e.buffer.String(), nil
 }
 
+// contentObject resolves a /Contents entry. A stream with a filter that has
+// no decoder or that exceeds a reader limit is an error; other resolution
+// failures leave the page empty.
+func (e *Extractor) contentObject(obj Object) (Object, error) {
+	ref, ok := obj.(IndirectObject)
+	if !ok {
+		return obj, nil
+	}
+	res, err := e.reader.GetObject(ref)
+	if isFatal(err) {
+		return nil, err
+	}
+	if err != nil {
+		e.reader.warn("failed to resolve object %v: %v", ref, err)
+		return NullObject{}, nil
+	}
+	return res, nil
+}
+
+// runContent interprets a content stream. The operators of a page and the
+// forms it draws count together against the reader's MaxContentOps.
+func (e *Extractor) runContent(data []byte) error {
+	parser := NewContentStreamParser(data)
+	parser.lexer.maxDepth = e.reader.limits.MaxNestingDepth
+	maxOps := e.reader.limits.MaxContentOps
+	for {
+		op, err := parser.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		e.ops++
+		if maxOps >= 0 && e.ops > maxOps {
+			return &LimitError{Limit: "MaxContentOps", Max: int64(maxOps)}
+		}
+		e.processOp(*op)
+		if e.limitErr != nil {
+			return e.limitErr
+		}
+	}
+}
+
 func (e *Extractor) processOp(op Operation) {
 	switch op.Operator {
 	case "q":
 		e.gStack = append(e.gStack, e.gState)
 	case "Q":
-		if len(e.gStack) > 0 {
+		if len(e.gStack) > e.gBase {
 			e.gState = e.gStack[len(e.gStack)-1]
 			e.gStack = e.gStack[:len(e.gStack)-1]
 		}
@@ -565,6 +526,14 @@ func (e *Extractor) processOp(op Operation) {
 		e.textState.Scale = number(op.Operands[0])
 	case "TL":
 		e.textState.Leading = number(op.Operands[0])
+	case "Tr":
+		if len(op.Operands) > 0 {
+			e.textState.RenderMode = int(number(op.Operands[0]))
+		}
+	case "Ts":
+		if len(op.Operands) > 0 {
+			e.textState.Rise = number(op.Operands[0])
+		}
 	case "Tf":
 		if name, ok := op.Operands[0].(NameObject); ok {
 			if font, ok := e.fonts[string(name)]; ok {
@@ -600,6 +569,13 @@ func (e *Extractor) processOp(op Operation) {
 		if arr, ok := op.Operands[0].(ArrayObject); ok {
 			for _, obj := range arr {
 				if numObj, ok := obj.(NumberObject); ok {
+					if e.textState.Font != nil && e.textState.Font.Vertical {
+						// Vertical adjustment: -num/1000 * fontsize along y
+						shift := -float64(numObj) / 1000.0 * e.textState.FontSize
+						e.textState.TM[4] += shift * e.textState.TM[2]
+						e.textState.TM[5] += shift * e.textState.TM[3]
+						continue
+					}
 					// Adjustment: -num/1000 * fontsize * scale
 					shift := -float64(numObj) / 1000.0 * e.textState.FontSize * (e.textState.Scale / 100.0)
 					e.textState.TM[4] += shift * e.textState.TM[0]
@@ -617,6 +593,24 @@ func (e *Extractor) processOp(op Operation) {
 		e.textState.CharSpacing = number(op.Operands[1])
 		e.processOp(Operation{Operator: "T*"})
 		e.processOp(Operation{Operator: "Tj", Operands: op.Operands[2:]})
+	case "g":
+		e.setFillColor("/DeviceGray", op.Operands)
+	case "rg":
+		e.setFillColor("/DeviceRGB", op.Operands)
+	case "k":
+		e.setFillColor("/DeviceCMYK", op.Operands)
+	case "cs":
+		if len(op.Operands) > 0 {
+			if name, ok := op.Operands[0].(NameObject); ok {
+				e.setFillColorSpace(name)
+			}
+		}
+	case "sc", "scn":
+		e.setFillColor("", op.Operands)
+	case "m", "l", "c", "v", "y", "h", "re", "S", "s", "f", "F", "f*", "B", "B*", "b", "b*", "n":
+		if e.opts.RulingSink != nil {
+			e.processPathOp(op)
+		}
 	case "INLINE_IMAGE":
 		// Handle inline image placeholder (only if extraction enabled)
 		if e.images != nil {
@@ -627,15 +621,390 @@ func (e *Extractor) processOp(op Operation) {
 			}
 		}
 	case "Do":
-		// Handle XObject (image) reference (only if extraction enabled)
-		if e.images != nil {
-			if len(op.Operands) > 0 {
-				if name, ok := op.Operands[0].(NameObject); ok {
-					e.recordImage(string(name))
+		// Forms are always drawn (they carry text); images only recorded if extraction enabled
+		if len(op.Operands) > 0 {
+			if name, ok := op.Operands[0].(NameObject); ok {
+				e.doXObject(string(name))
+			}
+		}
+	}
+}
+
+// loadType3 reads the /FontMatrix and CharProcs of a Type3 font.
+// Type3 widths are in glyph space, so they are converted to the usual
+// 1/1000 text space units here and handleText needs no special case.
+func (e *Extractor) loadType3(f *Font, obj DictionaryObject) {
+	f.FontMatrix = Matrix{0.001, 0, 0, 0.001, 0, 0}
+	if fm, ok := e.reader.Resolve(obj["/FontMatrix"]).(ArrayObject); ok && len(fm) == 6 {
+		resolved := make([]Object, 6)
+		for i := range fm {
+			resolved[i] = e.reader.Resolve(fm[i])
+		}
+		f.FontMatrix = argsToMatrix(resolved)
+	}
+
+	scale := f.FontMatrix[0] * 1000
+	for code, w := range f.Widths {
+		f.Widths[code] = w * scale
+	}
+	f.MissingW *= scale
+	if box, ok := e.reader.rectangle(obj["/FontBBox"]); ok {
+		yScale := f.FontMatrix[3] * 1000
+		f.FontBBox = Rectangle{
+			LLX: box.LLX * scale, LLY: math.Min(box.LLY*yScale, box.URY*yScale),
+			URX: box.URX * scale, URY: math.Max(box.LLY*yScale, box.URY*yScale),
+		}
+	}
+
+	if procs, ok := e.reader.Resolve(obj["/CharProcs"]).(DictionaryObject); ok {
+		f.charProcs = &type3Procs{reader: e.reader, procs: procs, painters: make(map[string]bool)}
+	}
+}
+
+// type3Procs holds the CharProcs of a Type3 font. A glyph description is
+// only decoded when a code has no other text, and the result is kept per
+// glyph name; fonts are shared between extractors, hence the lock.
+type type3Procs struct {
+	reader   *Reader
+	procs    DictionaryObject
+	mu       sync.Mutex
+	painters map[string]bool // Glyph name -> CharProc paints a path or image
+}
+
+// paints reports whether the named glyph's CharProc paints a path or image
+func (t *type3Procs) paints(name string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if p, ok := t.painters[name]; ok {
+		return p
+	}
+	p := false
+	if proc, ok := t.reader.Resolve(t.procs[name]).(StreamObject); ok {
+		if data, err := proc.Data(); err == nil {
+			p = charProcPaints(data)
+		}
+	}
+	t.painters[name] = p
+	return p
+}
+
+// charProcPaints reports whether a Type3 glyph description paints a path, image or shading
+func charProcPaints(data []byte) bool {
+	parser := NewContentStreamParser(data)
+	for {
+		op, err := parser.Next()
+		if err != nil {
+			return false
+		}
+		switch op.Operator {
+		case "f", "F", "f*", "B", "B*", "b", "b*", "S", "s", "sh", "Do", "INLINE_IMAGE":
+			return true
+		}
+	}
+}
+
+// type3Unmapped reports whether a Type3 code draws a glyph that only paints
+// shapes and whose name gives no text, so any text for it would be a guess.
+func (f *Font) type3Unmapped(b byte) bool {
+	if f.charProcs == nil {
+		return false
+	}
+	if _, ok := f.CMap.Lookup([]byte{b}); ok {
+		return false
+	}
+	name, ok := f.Encoding[int(b)]
+	if !ok {
+		return false
+	}
+	if _, ok := glyphNameToUnicode(name); ok {
+		return false
+	}
+	if _, ok := glyphNameCode(name); ok {
+		return false
+	}
+	if len(name) == 2 && isPrintableASCII(name[1]) {
+		return false
+	}
+	return f.charProcs.paints(name)
+}
+
+// parseFontDescriptor reads the font flags, /FontBBox and /MissingWidth
+func (e *Extractor) parseFontDescriptor(f *Font, fdObj Object) {
+	fd, ok := e.reader.Resolve(fdObj).(DictionaryObject)
+	if !ok {
+		return
+	}
+	if flags, ok := e.reader.Resolve(fd["/Flags"]).(NumberObject); ok {
+		f.Flags = int(flags)
+	}
+	if mw, ok := e.reader.Resolve(fd["/MissingWidth"]).(NumberObject); ok {
+		f.MissingW = float64(mw)
+	}
+	if box, ok := e.reader.rectangle(fd["/FontBBox"]); ok {
+		f.FontBBox = box
+	}
+	f.program = e.loadFontProgram(fd)
+}
+
+// loadCIDFont reads the encoding CMap and the descendant CIDFont metrics of a Type0 font
+func (e *Extractor) loadCIDFont(f *Font, obj DictionaryObject) {
+	f.IsCID = true
+	f.MissingW = 1000                         // /DW default
+	f.DefaultVMetric = [2]float64{880, -1000} // /DW2 default
+	f.VMetrics = make(map[int][3]float64)
+
+	// Encoding: predefined CMap name or embedded CMap stream
+	switch enc := e.reader.Resolve(obj["/Encoding"]).(type) {
+	case NameObject:
+		f.predefined, f.Vertical = lookupPredefinedCMap(string(enc))
+	case StreamObject:
+		if cmap, err := parseCMapStream(enc); err == nil {
+			f.predefined = e.resolveUseCMap(cmap, enc.Dictionary["/UseCMap"], 0)
+			f.CIDEncoding = cmap
+			f.Vertical = cmap.WMode == 1
+		}
+		if wmode, ok := e.reader.Resolve(enc.Dictionary["/WMode"]).(NumberObject); ok {
+			f.Vertical = wmode == 1
+		}
+	}
+
+	descendants, ok := e.reader.Resolve(obj["/DescendantFonts"]).(ArrayObject)
+	if !ok || len(descendants) == 0 {
+		return
+	}
+	cidFont, ok := e.reader.Resolve(descendants[0]).(DictionaryObject)
+	if !ok {
+		return
+	}
+
+	e.parseFontDescriptor(f, cidFont["/FontDescriptor"])
+	if csi, ok := e.reader.Resolve(cidFont["/CIDSystemInfo"]).(DictionaryObject); ok {
+		registry, _ := e.reader.Resolve(csi["/Registry"]).(StringObject)
+		ordering, _ := e.reader.Resolve(csi["/Ordering"]).(StringObject)
+		f.Ordering = string(registry) + "-" + string(ordering)
+	}
+	if dw, ok := e.reader.Resolve(cidFont["/DW"]).(NumberObject); ok {
+		f.MissingW = float64(dw)
+	}
+	if dw2, ok := e.reader.Resolve(cidFont["/DW2"]).(ArrayObject); ok && len(dw2) == 2 {
+		for i := range dw2 {
+			if v, ok := e.reader.Resolve(dw2[i]).(NumberObject); ok {
+				f.DefaultVMetric[i] = float64(v)
+			}
+		}
+	}
+	if m, ok := e.reader.Resolve(cidFont["/CIDToGIDMap"]).(StreamObject); ok {
+		if data, err := m.Data(); err == nil {
+			f.cidToGID = make([]uint16, len(data)/2)
+			for i := range f.cidToGID {
+				f.cidToGID[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+			}
+		}
+	}
+	if w, ok := e.reader.Resolve(cidFont["/W"]).(ArrayObject); ok {
+		e.parseCIDWidths(w, 1, func(cid int, m []float64) {
+			f.Widths[cid] = m[0]
+		})
+	}
+	if w2, ok := e.reader.Resolve(cidFont["/W2"]).(ArrayObject); ok {
+		e.parseCIDWidths(w2, 3, func(cid int, m []float64) {
+			f.VMetrics[cid] = [3]float64{m[0], m[1], m[2]}
+		})
+	}
+}
+
+// resolveUseCMap links a CMap to its parent, given by the stream's /UseCMap
+// entry or named by usecmap inside the CMap. A predefined parent is returned
+// so its code -> Unicode mapping can serve as a fallback.
+func (e *Extractor) resolveUseCMap(cmap *CMap, useObj Object, depth int) *predefinedCMap {
+	if depth >= maxUseCMapDepth {
+		return nil
+	}
+
+	name := cmap.UseCMap
+	switch use := e.reader.Resolve(useObj).(type) {
+	case StreamObject:
+		parent, err := parseCMapStream(use)
+		if err != nil {
+			return nil
+		}
+		cmap.Parent = parent
+		return e.resolveUseCMap(parent, use.Dictionary["/UseCMap"], depth+1)
+	case NameObject:
+		name = string(use)
+	}
+
+	switch name {
+	case "":
+		return nil
+	case "/Identity-H", "/Identity-V":
+		cmap.Parent = identityCMap
+		return nil
+	}
+	p, vertical := lookupPredefinedCMap(name)
+	if p != nil && p.form == formLegacy {
+		cmap.Parent = p.encoding(vertical)
+	}
+	return p
+}
+
+// parseCIDWidths walks a /W or /W2 array, where each metric is n numbers wide.
+// Entries take two forms:
+//
+//	c [m1 m2 ...]         consecutive CIDs starting at c
+//	cfirst clast m        the same metric for every CID in the range
+func (e *Extractor) parseCIDWidths(arr ArrayObject, n int, set func(cid int, m []float64)) {
+	nums := func(objs []Object) ([]float64, bool) {
+		out := make([]float64, len(objs))
+		for i, o := range objs {
+			v, ok := e.reader.Resolve(o).(NumberObject)
+			if !ok {
+				return nil, false
+			}
+			out[i] = float64(v)
+		}
+		return out, true
+	}
+
+	for i := 0; i+1 < len(arr); {
+		first, ok := e.reader.Resolve(arr[i]).(NumberObject)
+		if !ok {
+			return
+		}
+
+		if list, ok := e.reader.Resolve(arr[i+1]).(ArrayObject); ok {
+			vals, ok := nums(list)
+			if !ok {
+				return
+			}
+			for j := 0; j+n <= len(vals); j += n {
+				set(int(first)+j/n, vals[j:j+n])
+			}
+			i += 2
+			continue
+		}
+
+		if i+2+n > len(arr) {
+			return
+		}
+		bounds, ok := nums(arr[i+1 : i+2+n])
+		if !ok {
+			return
+		}
+		last := int(bounds[0])
+		// Guard against absurd ranges in damaged files
+		if last < int(first) || last-int(first) > 0xFFFF {
+			i += 2 + n
+			continue
+		}
+		for cid := int(first); cid <= last; cid++ {
+			set(cid, bounds[1:])
+		}
+		i += 2 + n
+	}
+}
+
+// codeLength returns the byte length of the next character code in data
+func (f *Font) codeLength(data []byte) int {
+	if !f.IsCID {
+		return 1
+	}
+	if f.CIDEncoding != nil {
+		if n := f.CIDEncoding.codeLength(data); n > 0 {
+			return n
+		}
+	}
+	if f.predefined != nil {
+		return f.predefined.codeLength(data)
+	}
+	// Identity-H/V and other predefined CMaps without a table: 2-byte codes
+	return min(2, len(data))
+}
+
+// cid returns the CID selected by a character code
+func (f *Font) cid(code []byte) (int, bool) {
+	if f.CIDEncoding != nil {
+		return f.CIDEncoding.CID(code)
+	}
+	if f.predefined != nil {
+		return f.predefined.cid(code, f.Vertical)
+	}
+	return hexToInt(HexStringObject(code)), true
+}
+
+// gid returns the glyph index of a CID through /CIDToGIDMap
+func (f *Font) gid(cid int) int {
+	if f.cidToGID == nil {
+		return cid
+	}
+	if cid < 0 || cid >= len(f.cidToGID) {
+		return 0
+	}
+	return int(f.cidToGID[cid])
+}
+
+// decodeCode maps one character code to Unicode
+func (f *Font) decodeCode(code []byte) string {
+	if f.CMap != nil {
+		if val, ok := f.CMap.Lookup(code); ok {
+			return val
+		}
+		// Some producers write 2-byte source codes for simple fonts
+		if !f.IsCID {
+			if val, ok := f.CMap.lookupKey(2<<32 | uint64(code[0])); ok {
+				return val
+			}
+		}
+	}
+	if f.IsCID {
+		// No ToUnicode entry: Uni* codes are Unicode already, other codes
+		// go through their CID and the character collection
+		if f.predefined != nil && f.predefined.form != formLegacy {
+			return f.predefined.toUnicode(code)
+		}
+		if cid, ok := f.cid(code); ok {
+			if f.program != nil && f.predefined == nil {
+				if text, ok := f.program.glyphText(f.gid(cid)); ok {
+					return text
 				}
 			}
+			ordering := f.Ordering
+			if f.predefined != nil {
+				ordering = f.predefined.ordering
+			}
+			if text := orderingToUnicode(ordering, cid); text != "" {
+				return text
+			}
+		}
+		// Legacy codes without a CID mapping: decode the national encoding
+		if f.predefined != nil {
+			return f.predefined.toUnicode(code)
 		}
+		return ""
 	}
+	return f.decodeSimpleCode(code[0])
+}
+
+// advance returns the glyph displacement for a code in glyph space units (1/1000 em).
+// For vertical fonts this is the w1y component from /W2 or /DW2.
+func (f *Font) advance(code []byte) float64 {
+	if !f.IsCID {
+		if w, ok := f.Widths[int(code[0])]; ok {
+			return w
+		}
+		return f.MissingW
+	}
+	cid, ok := f.cid(code)
+	if f.Vertical {
+		if m, found := f.VMetrics[cid]; ok && found {
+			return m[0]
+		}
+		return f.DefaultVMetric[1]
+	}
+	if w, found := f.Widths[cid]; ok && found {
+		return w
+	}
+	return f.MissingW
 }
 
 // handleText calculates position using REAL font metrics if possible
@@ -687,98 +1056,76 @@ func (e *Extractor) handleText(obj Object) {
 		}
 	}
 
-	// 3. Decode Text
+	// 3. Decode Text and measure the string code by code
+	font := e.textState.Font
+	fs := e.textState.FontSize
+	hScale := e.textState.Scale / 100.0
+
+	// Simple fonts without /Widths (standard 14) fall back to 0.5 em per char
+	useMetrics := font != nil && (font.IsCID || len(font.Widths) > 0)
+
 	var decoded strings.Builder
-	if e.textState.Font != nil && e.textState.Font.CMap != nil && len(e.textState.Font.CMap.Map) > 0 {
-		i := 0
-		for i < len(rawBytes) {
-			// Try 2 bytes
-			if i+1 < len(rawBytes) {
-				key := string(rawBytes[i : i+2])
-				if val, ok := e.textState.Font.CMap.Map[key]; ok {
-					decoded.WriteString(val)
-					i += 2
-					continue
-				}
-			}
-			// Try 1 byte
-			key := string(rawBytes[i : i+1])
-			if val, ok := e.textState.Font.CMap.Map[key]; ok {
-				decoded.WriteString(val)
-				i++
-				continue
-			}
-			// Fallback
-			decoded.WriteByte(rawBytes[i])
-			i++
-		}
-	} else if e.textState.Font != nil && len(e.textState.Font.Encoding) > 0 {
-		// Use /Encoding dictionary to map character codes to glyphs
-		for _, b := range rawBytes {
-			code := int(b)
-			if glyphName, ok := e.textState.Font.Encoding[code]; ok {
-				// Map glyph name to Unicode
-				if unicode, ok := glyphToUnicode[glyphName]; ok {
-					decoded.WriteString(unicode)
-				} else {
-					// Unknown glyph, try to extract character from name
-					// e.g., "/a" -> 'a'
-					if len(glyphName) == 2 && glyphName[0] == '/' {
-						decoded.WriteByte(glyphName[1])
-					} else {
-						// Fallback: use the byte value as-is
-						decoded.WriteByte(b)
-					}
-				}
-			} else {
-				// No encoding entry, use byte value as-is (standard ASCII)
-				decoded.WriteByte(b)
+	totalWidth := 0.0
+	vertical := font != nil && font.Vertical && useMetrics
+	glyphs := e.opts.GlyphSink != nil
+	if font == nil {
+		// No font selected - fallback to direct byte conversion
+		decoded.WriteString(filterControlChars(rawBytes))
+		totalWidth = float64(decoded.Len()) * fs * 0.5 * hScale
+		if glyphs {
+			text := decoded.String()
+			for i := 0; i < len(text); i++ {
+				w := fs * 0.5 * hScale
+				e.emitGlyph(fm, text[i:i+1], []byte{text[i]}, float64(i)*w, w, false)
 			}
 		}
-	} else {
-		// No CMap and no Encoding - fallback to direct byte conversion
-		// Filter out non-printable control characters
-		decoded.WriteString(filterControlChars(rawBytes))
 	}
+	for i := 0; font != nil && i < len(rawBytes); {
+		n := font.codeLength(rawBytes[i:])
+		code := rawBytes[i : i+n]
+		i += n
+
+		text := font.decodeCode(code)
+		if e.opts.UnmappedGlyph != "" && n == 1 && font.type3Unmapped(code[0]) {
+			text = e.opts.UnmappedGlyph
+		}
+		decoded.WriteString(text)
 
-	e.buffer.WriteString(decoded.String())
-
-	// 4. Calculate total width of this string to update lastX
-	totalWidth := 0.0
-
-	if e.textState.Font != nil && len(e.textState.Font.Widths) > 0 {
-		// Use Widths Map
-		for _, b := range rawBytes {
-			code := int(b)
-			w := e.textState.Font.MissingW
-			if val, ok := e.textState.Font.Widths[code]; ok {
-				w = val
+		if !useMetrics {
+			w := float64(utf8.RuneCountInString(text)) * fs * 0.5 * hScale
+			if glyphs {
+				e.emitGlyph(fm, text, code, totalWidth, w, false)
 			}
-			// Add width + char spacing + word spacing (if space)
 			totalWidth += w
-
-			// Note: This simple loop assumes 1-byte char codes for widths.
-			// Complex CID fonts are harder, but this covers standard pdfTeX.
+			continue
 		}
-		// Convert to user space
-		// width = (sum(w)/1000 * fs + charSpacing + wordSpacing) * scale
-		// Simplified: we sum the raw widths first.
-		totalWidth = (totalWidth / 1000.0) * e.textState.FontSize
-
-		// Add CharSpacing * count
-		totalWidth += float64(len(rawBytes)) * e.textState.CharSpacing
 
-		// Add WordSpacing (approximation: count spaces in decoded)
-		// Better: check raw code 32, but decoded is safer for generic check
-		decodedStr := decoded.String()
-		spaceCount := strings.Count(decodedStr, " ")
-		totalWidth += float64(spaceCount) * e.textState.WordSpacing
+		// Displacement = w0/1000 * fs + Tc + Tw (Tw only for single-byte code 32)
+		glyphW := font.advance(code) / 1000.0 * fs
+		w := glyphW + e.textState.CharSpacing
+		if n == 1 && code[0] == ' ' {
+			w += e.textState.WordSpacing
+		}
+		if !vertical {
+			w *= hScale
+			glyphW *= hScale
+		}
+		if glyphs {
+			e.emitGlyph(fm, text, code, totalWidth, glyphW, vertical)
+		}
+		totalWidth += w
+	}
 
-		totalWidth *= (e.textState.Scale / 100.0)
+	e.buffer.WriteString(decoded.String())
 
-	} else {
-		// Fallback Heuristic (0.5 em per char)
-		totalWidth = float64(decoded.Len()) * e.textState.FontSize * 0.5 * (e.textState.Scale / 100.0)
+	if vertical {
+		// Vertical fonts advance along the text space y axis (w1y is negative).
+		// Track the pen position so the next string in the same column continues the line.
+		e.textState.TM[4] += totalWidth * e.textState.TM[2]
+		e.textState.TM[5] += totalWidth * e.textState.TM[3]
+		end := e.textState.TM.Mult(e.gState.CTM)
+		e.lastX, e.lastY = end[4], end[5]
+		return
 	}
 
 	e.lastX = x + totalWidth
@@ -818,14 +1165,14 @@ func (e *Extractor) recordInlineImage(dict DictionaryObject) {
 	*e.images = append(*e.images, img)
 }
 
-// recordImage records an XObject image reference
-func (e *Extractor) recordImage(name string) {
+// doXObject draws a named XObject: Forms are interpreted, Images recorded
+func (e *Extractor) doXObject(name string) {
 	if e.xobjects == nil {
 		return
 	}
 
-	// Resolve XObject to get metadata
-	xobj := e.reader.Resolve(e.xobjects[name])
+	ref := e.xobjects[name]
+	xobj := e.reader.Resolve(ref)
 
 	// XObjects can be either DictionaryObject or StreamObject
 	var xobjDict DictionaryObject
@@ -839,122 +1186,88 @@ func (e *Extractor) recordImage(name string) {
 	}
 
 	// Check the subtype - can be /Image or /Form
-	if subtype, ok := e.reader.Resolve(xobjDict["/Subtype"]).(NameObject); ok {
-		if string(subtype) == "/Form" {
-			// Form XObjects contain nested content streams that may reference images
-			e.processFormXObject(xobj)
-			return
+	subtype, _ := e.reader.Resolve(xobjDict["/Subtype"]).(NameObject)
+	switch string(subtype) {
+	case "/Form":
+		if stream, ok := xobj.(StreamObject); ok {
+			objNum := 0
+			if indRef, ok := ref.(IndirectObject); ok {
+				objNum = indRef.ObjectNumber
+			}
+			e.runForm(stream, objNum)
 		}
-
-		if string(subtype) != "/Image" {
-			return
+	case "/Image":
+		if e.images != nil {
+			e.recordImage(name, xobjDict)
 		}
-	} else {
-		return
 	}
-
-	img := model.Image{
-		Type: "image",
-		ID:   name,
-		Rect: e.calculateImageRect(),
-	}
-
-	// Extract image metadata
-	if w, ok := e.reader.Resolve(xobjDict["/Width"]).(NumberObject); ok {
-		img.Width = float64(w)
-	}
-	if h, ok := e.reader.Resolve(xobjDict["/Height"]).(NumberObject); ok {
-		img.Height = float64(h)
-	}
-	if cs, ok := e.reader.Resolve(xobjDict["/ColorSpace"]).(NameObject); ok {
-		img.ColorSpace = string(cs)
-	}
-
-	*e.images = append(*e.images, img)
 }
 
-// processFormXObject recursively processes a Form XObject to find nested images
-// Removed 'name' parameter as it was unused
-func (e *Extractor) processFormXObject(xobj Object) {
-	// Form XObjects are StreamObjects containing a content stream
-	streamObj, ok := xobj.(StreamObject)
-	if !ok {
+// runForm interprets a Form XObject's content stream through the same state
+// machine as the page. The call is wrapped in a save/restore of the graphics
+// and text state, the form /Matrix is concatenated to the CTM, and the form's
+// own /Resources (if any) replace the current ones while it runs.
+func (e *Extractor) runForm(form StreamObject, objNum int) {
+	if objNum != 0 && e.activeForms[objNum] {
+		return // Cycle: the form draws itself
+	}
+	if e.formDepth >= maxFormDepth {
 		return
 	}
-
-	// Get the Form's Resources dictionary (if any)
-	formDict := streamObj.Dictionary
-	var formResources DictionaryObject
-	if res, ok := e.reader.Resolve(formDict["/Resources"]).(DictionaryObject); ok {
-		formResources = res
+	e.formDepth++
+	defer func() { e.formDepth-- }()
+	if e.activeForms == nil {
+		e.activeForms = make(map[int]bool)
+	}
+	if objNum != 0 {
+		e.activeForms[objNum] = true
+		defer delete(e.activeForms, objNum)
 	}
 
-	// Parse the form's content stream to find Do operators (image references)
-	parser := NewContentStreamParser(streamObj.Data)
-
-	for {
-		op, err := parser.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			break
-		}
-
-		// Look for Do operator (XObject invocation)
-		if op.Operator == "Do" && len(op.Operands) > 0 {
-			if imgName, ok := op.Operands[0].(NameObject); ok {
-
-				// Get the nested XObject from the form's resources
-				if formResources != nil {
-					if nestedXObjects, ok := e.reader.Resolve(formResources["/XObject"]).(DictionaryObject); ok {
-						if nestedXObj := e.reader.Resolve(nestedXObjects[string(imgName)]); nestedXObj != nil {
-							// Recursively process this XObject
-							e.recordNestedImage(string(imgName), nestedXObj)
-						}
-					}
-				}
-			}
+	// Save state (q). The form may not pop the states saved outside it,
+	// and any unbalanced q inside it is discarded on return.
+	savedG, savedText, savedBase := e.gState, e.textState, e.gBase
+	savedStack := append([]GraphicsState(nil), e.gStack...)
+	savedFonts, savedXObjects := e.fonts, e.xobjects
+	defer func() {
+		// Restore state (Q)
+		e.gState, e.textState, e.gStack, e.gBase = savedG, savedText, savedStack, savedBase
+		e.fonts, e.xobjects = savedFonts, savedXObjects
+	}()
+	e.gStack = append(e.gStack, e.gState)
+	e.gBase = len(e.gStack)
+
+	if m, ok := e.reader.Resolve(form.Dictionary["/Matrix"]).(ArrayObject); ok && len(m) == 6 {
+		resolved := make([]Object, 6)
+		for i := range m {
+			resolved[i] = e.reader.Resolve(m[i])
 		}
+		e.gState.CTM = argsToMatrix(resolved).Mult(e.gState.CTM)
 	}
-}
-
-// recordNestedImage handles images found within Form XObjects
-func (e *Extractor) recordNestedImage(name string, xobj Object) {
-	// Similar to recordImage but for nested objects
-	var xobjDict DictionaryObject
-	switch obj := xobj.(type) {
-	case DictionaryObject:
-		xobjDict = obj
-	case StreamObject:
-		xobjDict = obj.Dictionary
-	default:
-		return
+	if res, ok := e.reader.Resolve(form.Dictionary["/Resources"]).(DictionaryObject); ok {
+		e.loadResources(res)
 	}
 
-	// Check subtype
-	if subtype, ok := e.reader.Resolve(xobjDict["/Subtype"]).(NameObject); ok {
-
-		if string(subtype) == "/Form" {
-			// Another nested form - recurse
-			e.processFormXObject(xobj)
-			return
-		}
-
-		if string(subtype) != "/Image" {
-			return
-		}
-	} else {
-		return
+	// A damaged form only loses its own remaining content, but an exceeded
+	// limit stops the whole page
+	data, err := form.Data()
+	if err == nil {
+		err = e.runContent(data)
 	}
+	if errors.Is(err, ErrLimitExceeded) {
+		e.limitErr = err
+	}
+}
 
-	// It's an image - record it
+// recordImage records an XObject image reference
+func (e *Extractor) recordImage(name string, xobjDict DictionaryObject) {
 	img := model.Image{
 		Type: "image",
 		ID:   name,
 		Rect: e.calculateImageRect(),
 	}
 
+	// Extract image metadata
 	if w, ok := e.reader.Resolve(xobjDict["/Width"]).(NumberObject); ok {
 		img.Width = float64(w)
 	}
@@ -0,0 +1,504 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Stream filters (PDF 32000-1 7.4).
+//
+// Image compression filters (/DCTDecode, /JPXDecode, /JBIG2Decode,
+// /CCITTFaxDecode) have no built-in decoder. Like any other filter without
+// one they fail with an *UnsupportedFilterError, which carries the data
+// still encoded with that filter for callers that want e.g. the JPEG bytes:
+//
+//	data, err := stream.Data()
+//	var unsupported *pdf.UnsupportedFilterError
+//	if errors.As(err, &unsupported) {
+//		data = unsupported.Data
+//	}
+
+// FilterFunc decodes data encoded with one filter. parms is the filter's
+// /DecodeParms dictionary with indirect values resolved, or nil.
+type FilterFunc func(data []byte, parms DictionaryObject) ([]byte, error)
+
+// UnsupportedFilterError is returned when a stream uses a filter that has
+// no decoder.
+type UnsupportedFilterError struct {
+	Filter string // Filter name, e.g. "/JBIG2Decode"
+	Data   []byte // Stream data decoded up to Filter, still encoded with it
+}
+
+func (e *UnsupportedFilterError) Error() string {
+	return fmt.Sprintf("pdf: unsupported stream filter %s", e.Filter)
+}
+
+// decoder is the form of the built-in filters: they stop with errTooLarge
+// once their output would exceed limit bytes (no limit if negative).
+type decoder func(data []byte, parms DictionaryObject, limit int64) ([]byte, error)
+
+// errTooLarge is turned into a *LimitError by decodeStream
+var errTooLarge = errors.New("decoded stream too large")
+
+// builtinFilters maps filter names, including the abbreviations used by
+// inline images, to their decoders.
+var builtinFilters = map[string]decoder{
+	"/FlateDecode":     flateDecode,
+	"/Fl":              flateDecode,
+	"/LZWDecode":       lzwDecode,
+	"/LZW":             lzwDecode,
+	"/ASCII85Decode":   ascii85Decode,
+	"/A85":             ascii85Decode,
+	"/ASCIIHexDecode":  asciiHexDecode,
+	"/AHx":             asciiHexDecode,
+	"/RunLengthDecode": runLengthDecode,
+	"/RL":              runLengthDecode,
+	// Decryption happens before the filters run
+	"/Crypt": func(data []byte, _ DictionaryObject, _ int64) ([]byte, error) { return data, nil },
+}
+
+var (
+	filtersMu     sync.RWMutex
+	customFilters = map[string]FilterFunc{}
+)
+
+// RegisterFilter installs a decoder for a stream filter, replacing any
+// existing one, e.g.
+//
+//	pdf.RegisterFilter("/JBIG2Decode", decodeJBIG2)
+//
+// The leading slash of name is optional. Decoders may be called from
+// several goroutines at once. Their output is checked against the
+// Reader's MaxStreamSize once they return.
+func RegisterFilter(name string, fn FilterFunc) {
+	if !strings.HasPrefix(name, "/") {
+		name = "/" + name
+	}
+	filtersMu.Lock()
+	customFilters[name] = fn
+	filtersMu.Unlock()
+}
+
+func lookupFilter(name string) (decoder, bool) {
+	filtersMu.RLock()
+	fn, ok := customFilters[name]
+	filtersMu.RUnlock()
+	if ok {
+		return func(data []byte, parms DictionaryObject, _ int64) ([]byte, error) {
+			return fn(data, parms)
+		}, true
+	}
+	dec, ok := builtinFilters[name]
+	return dec, ok
+}
+
+// decodeStream runs the filters of a stream dictionary over its raw data.
+// A filter without a decoder is an *UnsupportedFilterError holding that
+// filter's input.
+func (r *Reader) decodeStream(dict DictionaryObject, data []byte) ([]byte, error) {
+	var names []string
+	var parms []DictionaryObject
+	switch f := r.Resolve(dict["/Filter"]).(type) {
+	case NameObject:
+		names = []string{string(f)}
+	case ArrayObject:
+		for _, o := range f {
+			if name, ok := r.Resolve(o).(NameObject); ok {
+				names = append(names, string(name))
+			}
+		}
+	}
+	switch p := r.Resolve(dict["/DecodeParms"]).(type) {
+	case DictionaryObject:
+		parms = []DictionaryObject{p}
+	case ArrayObject:
+		// One entry per filter, null where a filter takes no parameters
+		for _, o := range p {
+			d, _ := r.Resolve(o).(DictionaryObject)
+			parms = append(parms, d)
+		}
+	}
+
+	limit, exceeded := r.streamBudget()
+	for i, name := range names {
+		decode, ok := lookupFilter(name)
+		if !ok {
+			return data, &UnsupportedFilterError{Filter: name, Data: data}
+		}
+		var p DictionaryObject
+		if i < len(parms) && parms[i] != nil {
+			p = r.resolveDict(parms[i])
+		}
+		out, err := decode(data, p, limit)
+		if err == errTooLarge || (err == nil && limit >= 0 && int64(len(out)) > limit) {
+			return nil, exceeded
+		}
+		if err != nil {
+			return data, fmt.Errorf("%s: %w", name, err)
+		}
+		data = out
+	}
+	return data, nil
+}
+
+// resolveDict returns a copy of dict with indirect values resolved one level
+func (r *Reader) resolveDict(dict DictionaryObject) DictionaryObject {
+	out := make(DictionaryObject, len(dict))
+	for k, v := range dict {
+		out[k] = r.Resolve(v)
+	}
+	return out
+}
+
+// flateDecode inflates zlib data. Damaged streams keep whatever could be
+// inflated before the error.
+func flateDecode(data []byte, parms DictionaryObject, limit int64) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var src io.Reader = zr
+	if limit >= 0 {
+		src = io.LimitReader(zr, limit+1)
+	}
+	out, err := io.ReadAll(src)
+	if limit >= 0 && int64(len(out)) > limit {
+		return nil, errTooLarge
+	}
+	if err != nil && len(out) == 0 {
+		return nil, err
+	}
+	return unpredict(out, parms)
+}
+
+// lzwDecode decodes LZW data (7.4.4). /EarlyChange 1, the default, widens
+// codes one entry early as the original LZW implementations did.
+func lzwDecode(data []byte, parms DictionaryObject, limit int64) ([]byte, error) {
+	earlyChange := 1
+	if n, ok := parms["/EarlyChange"].(NumberObject); ok {
+		earlyChange = int(n)
+	}
+
+	const (
+		clearTable = 256
+		eod        = 257
+	)
+	var out []byte
+	table := make([][]byte, 258, 4096)
+	for i := 0; i < 256; i++ {
+		table[i] = []byte{byte(i)}
+	}
+	width := 9
+	var prev []byte
+
+	var bitBuf uint32
+	bits := 0
+	for pos := 0; ; {
+		for bits < width && pos < len(data) {
+			bitBuf = bitBuf<<8 | uint32(data[pos])
+			bits += 8
+			pos++
+		}
+		if bits < width {
+			break // Missing EOD marker
+		}
+		code := int(bitBuf>>uint(bits-width)) & (1<<uint(width) - 1)
+		bits -= width
+
+		switch {
+		case code == clearTable:
+			table = table[:258]
+			width = 9
+			prev = nil
+			continue
+		case code == eod:
+			return unpredict(out, parms)
+		}
+
+		var entry []byte
+		switch {
+		case code < len(table):
+			entry = table[code]
+		case code == len(table) && prev != nil:
+			// The KwKwK case: the code being defined by this very step
+			entry = append(append([]byte(nil), prev...), prev[0])
+		default:
+			return out, fmt.Errorf("invalid LZW code %d", code)
+		}
+		if limit >= 0 && int64(len(out)+len(entry)) > limit {
+			return nil, errTooLarge
+		}
+		out = append(out, entry...)
+
+		if prev != nil && len(table) < 4096 {
+			table = append(table, append(append([]byte(nil), prev...), entry[0]))
+		}
+		prev = entry
+
+		switch n := len(table) + earlyChange; {
+		case n >= 2048:
+			width = 12
+		case n >= 1024:
+			width = 11
+		case n >= 512:
+			width = 10
+		}
+	}
+	return unpredict(out, parms)
+}
+
+// ascii85Decode decodes base-85 data (7.4.3)
+func ascii85Decode(data []byte, _ DictionaryObject, _ int64) ([]byte, error) {
+	data = bytes.TrimPrefix(bytes.TrimLeft(data, "\x00\t\n\f\r "), []byte("<~"))
+	out := make([]byte, 0, len(data)*4/5)
+	var group [5]byte
+	n := 0
+	for _, c := range data {
+		switch {
+		case c == '~':
+			goto done
+		case isWhitespace(c):
+			continue
+		case c == 'z' && n == 0:
+			out = append(out, 0, 0, 0, 0)
+			continue
+		case c < '!' || c > 'u':
+			return out, fmt.Errorf("invalid ASCII85 character %q", c)
+		}
+		group[n] = c - '!'
+		n++
+		if n == 5 {
+			out = appendBase85(out, group, 4)
+			n = 0
+		}
+	}
+done:
+	if n == 1 {
+		return out, errors.New("truncated ASCII85 group")
+	}
+	if n > 0 {
+		// A final partial group is padded with 'u'
+		for i := n; i < 5; i++ {
+			group[i] = 'u' - '!'
+		}
+		out = appendBase85(out, group, n-1)
+	}
+	return out, nil
+}
+
+// appendBase85 appends the first n bytes of a decoded base-85 group
+func appendBase85(out []byte, group [5]byte, n int) []byte {
+	var v uint32
+	for _, d := range group {
+		v = v*85 + uint32(d)
+	}
+	b := [4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+	return append(out, b[:n]...)
+}
+
+// asciiHexDecode decodes hexadecimal data up to the '>' marker (7.4.2)
+func asciiHexDecode(data []byte, _ DictionaryObject, _ int64) ([]byte, error) {
+	out := make([]byte, 0, len(data)/2)
+	var hi byte
+	half := false
+	for _, c := range data {
+		var v byte
+		switch {
+		case c == '>':
+			goto done
+		case isWhitespace(c):
+			continue
+		case c >= '0' && c <= '9':
+			v = c - '0'
+		case c >= 'a' && c <= 'f':
+			v = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			v = c - 'A' + 10
+		default:
+			return out, fmt.Errorf("invalid ASCIIHex character %q", c)
+		}
+		if half {
+			out = append(out, hi<<4|v)
+		} else {
+			hi = v
+		}
+		half = !half
+	}
+done:
+	if half {
+		// An odd final digit is followed by an implied 0
+		out = append(out, hi<<4)
+	}
+	return out, nil
+}
+
+// runLengthDecode decodes byte-oriented run-length data (7.4.5)
+func runLengthDecode(data []byte, _ DictionaryObject, limit int64) ([]byte, error) {
+	var out []byte
+	for i := 0; i < len(data); {
+		// Runs expand at most 128 times
+		if limit >= 0 && int64(len(out)) > limit {
+			return nil, errTooLarge
+		}
+		n := int(data[i])
+		i++
+		switch {
+		case n == 128:
+			return out, nil
+		case n < 128:
+			end := i + n + 1
+			if end > len(data) {
+				end = len(data)
+			}
+			out = append(out, data[i:end]...)
+			i = end
+		default:
+			if i >= len(data) {
+				return out, nil
+			}
+			out = append(out, bytes.Repeat(data[i:i+1], 257-n)...)
+			i++
+		}
+	}
+	return out, nil
+}
+
+// unpredict reverses the /Predictor of Flate and LZW data (7.4.4.4):
+// 2 is TIFF predictor 2, 10 to 15 are PNG filters chosen per row.
+func unpredict(data []byte, parms DictionaryObject) ([]byte, error) {
+	predictor, colors, bpc, columns := 1, 1, 8, 1
+	if n, ok := parms["/Predictor"].(NumberObject); ok {
+		predictor = int(n)
+	}
+	if n, ok := parms["/Colors"].(NumberObject); ok && n >= 1 {
+		colors = int(n)
+	}
+	if n, ok := parms["/BitsPerComponent"].(NumberObject); ok && n >= 1 {
+		bpc = int(n)
+	}
+	if n, ok := parms["/Columns"].(NumberObject); ok && n >= 1 {
+		columns = int(n)
+	}
+	if colors > 32 || bpc > 16 || columns > 1<<20 {
+		return nil, errors.New("invalid predictor parameters")
+	}
+
+	rowBytes := (colors*bpc*columns + 7) / 8
+	bpp := (colors*bpc + 7) / 8
+	switch {
+	case predictor == 1:
+		return data, nil
+	case predictor == 2:
+		return tiffUnpredict(data, rowBytes, colors, bpc), nil
+	case predictor >= 10 && predictor <= 15:
+		return applyPngPredictor(data, rowBytes, bpp)
+	}
+	return nil, fmt.Errorf("unsupported predictor: %d", predictor)
+}
+
+// tiffUnpredict reverses TIFF predictor 2: each component is stored as the
+// difference from the same component of the previous pixel in the row
+func tiffUnpredict(data []byte, rowBytes, colors, bpc int) []byte {
+	out := append([]byte(nil), data...)
+	for row := 0; row+rowBytes <= len(out); row += rowBytes {
+		line := out[row : row+rowBytes]
+		switch bpc {
+		case 8:
+			for i := colors; i < len(line); i++ {
+				line[i] += line[i-colors]
+			}
+		case 16:
+			for i := 2 * colors; i+1 < len(line); i += 2 {
+				v := uint16(line[i])<<8 | uint16(line[i+1])
+				p := uint16(line[i-2*colors])<<8 | uint16(line[i-2*colors+1])
+				v += p
+				line[i], line[i+1] = byte(v>>8), byte(v)
+			}
+		default:
+			// Sub-byte components: work on the unpacked values
+			mask := 1<<uint(bpc) - 1
+			n := len(line) * 8 / bpc
+			get := func(i int) int {
+				bit := i * bpc
+				return int(line[bit/8]>>uint(8-bpc-bit%8)) & mask
+			}
+			set := func(i, v int) {
+				bit := i * bpc
+				shift := uint(8 - bpc - bit%8)
+				line[bit/8] = line[bit/8]&^byte(mask<<shift) | byte((v&mask)<<shift)
+			}
+			for i := colors; i < n; i++ {
+				set(i, get(i)+get(i-colors))
+			}
+		}
+	}
+	return out
+}
+
+// applyPngPredictor reverses PNG row filters. Each row of rowBytes bytes is
+// preceded by a filter type byte; bpp is the number of bytes per pixel.
+func applyPngPredictor(data []byte, rowBytes, bpp int) ([]byte, error) {
+	if rowBytes < 1 || bpp < 1 {
+		return nil, errors.New("invalid PNG predictor row size")
+	}
+	rowSize := rowBytes + 1
+	rowCount := len(data) / rowSize
+	out := make([]byte, rowCount*rowBytes)
+
+	// Previous row buffer (initially zero)
+	prevRow := make([]byte, rowBytes)
+
+	for i := 0; i < rowCount; i++ {
+		filter := data[i*rowSize]
+		rowData := data[i*rowSize+1 : (i+1)*rowSize]
+		outRow := out[i*rowBytes : (i+1)*rowBytes]
+
+		for x := 0; x < rowBytes; x++ {
+			var left, upperLeft byte
+			if x >= bpp {
+				left, upperLeft = outRow[x-bpp], prevRow[x-bpp]
+			}
+			upper := prevRow[x]
+			switch filter {
+			case 1: // Sub
+				outRow[x] = rowData[x] + left
+			case 2: // Up
+				outRow[x] = rowData[x] + upper
+			case 3: // Average
+				outRow[x] = rowData[x] + byte((int(left)+int(upper))/2)
+			case 4: // Paeth
+				outRow[x] = rowData[x] + byte(paethPredictor(int(left), int(upper), int(upperLeft)))
+			default: // None, or unknown treated as None
+				outRow[x] = rowData[x]
+			}
+		}
+		prevRow = outRow
+	}
+	return out, nil
+}
+
+func paethPredictor(a, b, c int) int {
+	p := a + b - c
+	pa := abs(p - a)
+	pb := abs(p - b)
+	pc := abs(p - c)
+	if pa <= pb && pa <= pc {
+		return a
+	} else if pb <= pc {
+		return b
+	}
+	return c
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
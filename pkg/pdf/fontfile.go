@@ -0,0 +1,185 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Embedded font programs (PDF 32000-1 9.9).
+//
+// Subset fonts often ship without /ToUnicode and with made-up /Differences
+// names, leaving the embedded program as the only record of what each code
+// draws. The readers in this file, truetype.go and cff.go recover glyph names
+// and Unicode values from it.
+
+// fontProgram holds what the extractor uses from an embedded font program
+type fontProgram struct {
+	encoding   *[256]string   // Built-in encoding, glyph names with "/" prefix
+	glyphNames map[int]string // GID -> glyph name with "/" prefix (post table, CFF charset)
+	gidUnicode map[int]rune   // GID -> code point from a Unicode cmap subtable
+
+	nameGID map[string]int // Reverse of glyphNames, built on first use
+}
+
+// glyphName returns a name for a glyph, preferring one derived from the Unicode cmap
+func (p *fontProgram) glyphName(gid int) string {
+	if r, ok := p.gidUnicode[gid]; ok {
+		if r <= 0xFFFF {
+			return fmt.Sprintf("/uni%04X", r)
+		}
+		return fmt.Sprintf("/u%X", r)
+	}
+	return p.glyphNames[gid]
+}
+
+// glyphText returns the text drawn by a glyph
+func (p *fontProgram) glyphText(gid int) (string, bool) {
+	if r, ok := p.gidUnicode[gid]; ok {
+		return string(r), true
+	}
+	if name, ok := p.glyphNames[gid]; ok {
+		return glyphNameToUnicode(name)
+	}
+	return "", false
+}
+
+// nameText resolves a glyph name that is not in the glyph list through the
+// font's own glyphs, e.g. a /Differences name such as "/g42" that the post
+// table assigns to a glyph the Unicode cmap also covers.
+func (p *fontProgram) nameText(name string) (string, bool) {
+	if p.nameGID == nil {
+		p.nameGID = make(map[string]int, len(p.glyphNames))
+		for gid, n := range p.glyphNames {
+			p.nameGID[n] = gid
+		}
+	}
+	gid, ok := p.nameGID[name]
+	if !ok {
+		return "", false
+	}
+	r, ok := p.gidUnicode[gid]
+	if !ok {
+		return "", false
+	}
+	return string(r), true
+}
+
+// loadFontProgram parses the embedded font program referenced by a font descriptor
+func (e *Extractor) loadFontProgram(fd DictionaryObject) *fontProgram {
+	var prog *fontProgram
+	var data []byte
+	var err error
+	if s, ok := e.reader.Resolve(fd["/FontFile2"]).(StreamObject); ok {
+		if data, err = s.Data(); err == nil {
+			prog, err = parseTrueType(data)
+		}
+	} else if s, ok := e.reader.Resolve(fd["/FontFile3"]).(StreamObject); ok {
+		subtype, _ := e.reader.Resolve(s.Dictionary["/Subtype"]).(NameObject)
+		if data, err = s.Data(); err == nil {
+			if subtype == "/OpenType" {
+				prog, err = parseTrueType(data)
+			} else {
+				prog, err = parseCFF(data)
+			}
+		}
+	} else if s, ok := e.reader.Resolve(fd["/FontFile"]).(StreamObject); ok {
+		length1, _ := e.reader.Resolve(s.Dictionary["/Length1"]).(NumberObject)
+		if data, err = s.Data(); err == nil {
+			prog, err = parseType1(data, int(length1))
+		}
+	}
+	if err != nil {
+		return nil
+	}
+	return prog
+}
+
+// parseType1 reads the /Encoding from the cleartext portion of a Type 1 font program:
+//
+//	/Encoding StandardEncoding def
+//	/Encoding 256 array ... dup 65 /A put ... readonly def
+func parseType1(data []byte, length1 int) (*fontProgram, error) {
+	clear := data
+	if length1 > 0 && length1 <= len(data) {
+		clear = data[:length1]
+	} else if i := bytes.Index(data, []byte("eexec")); i >= 0 {
+		clear = data[:i]
+	}
+
+	i := bytes.Index(clear, []byte("/Encoding"))
+	if i < 0 {
+		return &fontProgram{}, nil
+	}
+
+	prog := &fontProgram{}
+	var enc [256]string
+	tokens := psTokens(clear[i+len("/Encoding"):])
+	for t, tok := range tokens {
+		switch tok {
+		case "StandardEncoding":
+			enc = standardEncoding
+			prog.encoding = &enc
+			return prog, nil
+		case "put":
+			// dup <code> /<name> put
+			if t < 3 || tokens[t-3] != "dup" || !strings.HasPrefix(tokens[t-1], "/") {
+				continue
+			}
+			if code, err := strconv.Atoi(tokens[t-2]); err == nil && code >= 0 && code < 256 {
+				enc[code] = tokens[t-1]
+				prog.encoding = &enc
+			}
+		case "def", "readonly":
+			if prog.encoding != nil {
+				return prog, nil
+			}
+		}
+	}
+	return prog, nil
+}
+
+// psTokens splits PostScript source into tokens. Names keep their leading
+// slash; comments and string contents are dropped.
+func psTokens(data []byte) []string {
+	var tokens []string
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case isWhitespace(c):
+			i++
+		case c == '%':
+			for i < len(data) && data[i] != '\n' && data[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			// Skip the string, honouring nesting and escapes
+			depth := 0
+			for ; i < len(data); i++ {
+				if data[i] == '\\' {
+					i++
+				} else if data[i] == '(' {
+					depth++
+				} else if data[i] == ')' {
+					depth--
+					if depth == 0 {
+						i++
+						break
+					}
+				}
+			}
+		case isDelimiter(c) && c != '/':
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			start := i
+			i++
+			for i < len(data) && !isWhitespace(data[i]) && !isDelimiter(data[i]) {
+				i++
+			}
+			tokens = append(tokens, string(data[start:i]))
+		}
+	}
+	return tokens
+}
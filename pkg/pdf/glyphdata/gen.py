@@ -0,0 +1,100 @@
+#!/usr/bin/env python3
+"""Generates ../glyphtable.go, the glyph name -> Unicode table, from the Adobe
+Glyph List in glyphlist.txt.
+
+The AGL is followed as published, except for the names in OVERRIDES, where
+the AGL value carries no text: Adobe private use code points, presentation
+form ligatures that extraction should spell out, and the byte order mark.
+EXTRA adds common names the AGL lacks.
+
+Run from this directory: python3 gen.py && gofmt -w ../glyphtable.go
+"""
+import unicodedata
+
+OVERRIDES = {
+    # Ligatures are spelled out so that extracted words can be searched
+    "ff": "ff", "fi": "fi", "fl": "fl", "ffi": "ffi", "ffl": "ffl",
+    # Adobe corporate private use -> the characters they stand for
+    "copyrightsans": "©", "copyrightserif": "©",
+    "registersans": "®", "registerserif": "®",
+    "trademarksans": "™", "trademarkserif": "™",
+    "dotlessj": "ȷ", "commaaccent": "̦", "radicalex": "‾",
+    "arrowhorizex": "⎯", "arrowvertex": "⏐", "integralex": "⎮",
+    "parenlefttp": "⎛", "parenleftex": "⎜", "parenleftbt": "⎝",
+    "parenrighttp": "⎞", "parenrightex": "⎟", "parenrightbt": "⎠",
+    "bracketlefttp": "⎡", "bracketleftex": "⎢", "bracketleftbt": "⎣",
+    "bracketrighttp": "⎤", "bracketrightex": "⎥", "bracketrightbt": "⎦",
+    "bracelefttp": "⎧", "braceleftmid": "⎨", "braceleftbt": "⎩",
+    "braceex": "⎪",
+    "bracerighttp": "⎫", "bracerightmid": "⎬", "bracerightbt": "⎭",
+    # The AGL maps this to U+FEFF, which is a byte order mark in text
+    "zerowidthjoiner": "‍",
+}
+
+EXTRA = {
+    "st": "st", "ct": "ct", "ssharp": "ß", "circledot": "⊙",
+    "jupiter": "♃", "saturn": "♄", "uranus": "♅",
+    "neptune": "♆", "pluto": "♇",
+    "Adotaccent": "Ȧ", "adotaccent": "ȧ",
+    "Adotaccentmacron": "Ǡ", "adotaccentmacron": "ǡ",
+    "Ecedilla": "Ȩ", "ecedilla": "ȩ",
+    "Hcaron": "Ȟ", "hcaron": "ȟ",
+    "Ngrave": "Ǹ", "ngrave": "ǹ",
+    "Odieresismacron": "Ȫ", "odieresismacron": "ȫ",
+    "Otildemacron": "Ȭ", "otildemacron": "ȭ",
+    "Odotaccent": "Ȯ", "odotaccent": "ȯ",
+    "Odotaccentmacron": "Ȱ", "odotaccentmacron": "ȱ",
+    "Ymacron": "Ȳ", "ymacron": "ȳ",
+}
+
+# Figures named with a .superior or .inferior suffix
+for i, digit in enumerate("zero one two three four five six seven eight nine".split()):
+    EXTRA[digit + ".superior"] = "⁰¹²³⁴⁵⁶⁷⁸⁹"[i]
+    EXTRA[digit + ".inferior"] = chr(0x2080 + i)
+EXTRA["plus.superior"], EXTRA["minus.superior"] = "⁺", "⁻"
+EXTRA["plus.inferior"], EXTRA["minus.inferior"] = "₊", "₋"
+
+
+def read_agl(path):
+    table = {}
+    with open(path) as f:
+        for line in f:
+            if line.startswith("#") or not line.strip():
+                continue
+            name, codes = line.rstrip("\n").split(";")
+            table[name] = "".join(chr(int(c, 16)) for c in codes.split())
+    return table
+
+
+def quote(s):
+    out = []
+    for c in s:
+        cat = unicodedata.category(c)
+        if c in '"\\':
+            out.append("\\" + c)
+        elif cat[0] in "LNPS" or c == " ":
+            out.append(c)
+        else:
+            # Marks, controls and format characters are escaped to stay visible
+            out.append("\\u%04X" % ord(c) if ord(c) <= 0xFFFF else "\\U%08X" % ord(c))
+    return '"' + "".join(out) + '"'
+
+
+if __name__ == "__main__":
+    table = read_agl("glyphlist.txt")
+    for name in OVERRIDES:
+        assert name in table, name
+    for name in EXTRA:
+        assert name not in table, name
+    table.update(OVERRIDES)
+    table.update(EXTRA)
+
+    with open("../glyphtable.go", "w") as f:
+        f.write("// Code generated by glyphdata/gen.py from the Adobe Glyph List. DO NOT EDIT.\n\n")
+        f.write("package pdf\n\n")
+        f.write("// glyphToUnicode maps PostScript glyph names to Unicode characters\n")
+        f.write("var glyphToUnicode = map[string]string{\n")
+        for name in sorted(table):
+            f.write('\t"/%s": %s,\n' % (name, quote(table[name])))
+        f.write("}\n")
+    print("%d names" % len(table))
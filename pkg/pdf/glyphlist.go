@@ -0,0 +1,100 @@
+package pdf
+
+import (
+	"strconv"
+	"strings"
+)
+
+// glyphNameToUnicode resolves a glyph name such as "/eacute" to Unicode,
+// following the Adobe Glyph List Specification:
+//  1. Drop everything after the first period ("/a.sc" -> "a")
+//  2. Split ligatures into components on underscores ("/f_f_i")
+//  3. Map each component through the glyph list, or decode the
+//     "uniXXXX[XXXX...]" and "uXXXX[XX]" forms
+func glyphNameToUnicode(glyphName string) (string, bool) {
+	// Full names first: the table has some suffixed names ("/one.superior")
+	if unicode, ok := glyphToUnicode[glyphName]; ok {
+		return unicode, true
+	}
+
+	name := strings.TrimPrefix(glyphName, "/")
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name = name[:i] // ".notdef" becomes empty and maps to nothing
+	}
+	if name == "" {
+		return "", false
+	}
+
+	var sb strings.Builder
+	for _, component := range strings.Split(name, "_") {
+		// Unknown components map to nothing, as per the specification
+		sb.WriteString(glyphComponentToUnicode(component))
+	}
+	return sb.String(), sb.Len() > 0
+}
+
+// glyphComponentToUnicode maps a single ligature component
+func glyphComponentToUnicode(component string) string {
+	if unicode, ok := glyphToUnicode["/"+component]; ok {
+		return unicode
+	}
+
+	// uniXXXX: one or more UTF-16 code units without surrogates
+	if hex, ok := strings.CutPrefix(component, "uni"); ok && len(hex) >= 4 && len(hex)%4 == 0 {
+		var sb strings.Builder
+		for i := 0; i < len(hex); i += 4 {
+			v, err := strconv.ParseUint(hex[i:i+4], 16, 16)
+			if err != nil || (v >= 0xD800 && v <= 0xDFFF) {
+				return ""
+			}
+			sb.WriteRune(rune(v))
+		}
+		return sb.String()
+	}
+
+	// uXXXX to uXXXXXX: a single code point
+	if hex, ok := strings.CutPrefix(component, "u"); ok && len(hex) >= 4 && len(hex) <= 6 {
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || v > 0x10FFFF || (v >= 0xD800 && v <= 0xDFFF) {
+			return ""
+		}
+		return string(rune(v))
+	}
+
+	return ""
+}
+
+// glyphNameCode recovers the character code from names generated by
+// subsetting and conversion tools, which number glyphs instead of naming
+// them: "/Gxx" and "/gxxxx" (hex), "/Cdd[d]" and "/cdd[d]" (decimal) and
+// "/cidNNN" (decimal). The code is only trusted if it is printable.
+func glyphNameCode(glyphName string) (int, bool) {
+	name := strings.TrimPrefix(glyphName, "/")
+	if len(name) < 3 {
+		return 0, false
+	}
+
+	var digits string
+	base := 10
+	switch {
+	case strings.HasPrefix(name, "cid"):
+		digits = name[3:]
+	case name[0] == 'G' && len(name) == 3:
+		digits, base = name[1:], 16
+	case name[0] == 'g' && len(name) == 5:
+		digits, base = name[1:], 16
+	case (name[0] == 'C' || name[0] == 'c') && len(name) <= 4:
+		digits = name[1:]
+	default:
+		return 0, false
+	}
+
+	code, err := strconv.ParseUint(digits, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	if code < 0x20 || (code >= 0x7F && code < 0xA0) || code > 0xFFFF || (code >= 0xD800 && code <= 0xDFFF) {
+		return 0, false
+	}
+	return int(code), true
+}
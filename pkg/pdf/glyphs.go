@@ -0,0 +1,151 @@
+package pdf
+
+import (
+	"math"
+	"strings"
+)
+
+// Point is a position in page space (default user space).
+type Point struct {
+	X, Y float64
+}
+
+// Glyph is one character code shown on a page.
+type Glyph struct {
+	Text string // Unicode text; empty when the code cannot be mapped
+	Code []byte // Character code as shown in the string
+
+	// Quad is the glyph box in page space: lower-left, lower-right,
+	// upper-right and upper-left in the glyph's own orientation. It spans
+	// the advance width horizontally and the font bounding box vertically.
+	Quad [4]Point
+
+	FontName   string    // /BaseFont without the slash
+	FontSize   float64   // Size set by Tf
+	RenderMode int       // Text rendering mode set by Tr (3 is invisible)
+	ColorSpace string    // Fill color space, e.g. "/DeviceRGB"
+	FillColor  []float64 // Fill color components in ColorSpace
+	OpIndex    int       // Index of the showing operator among the page's operators, forms included
+
+	// For layout analysis, in page space
+	origin     Point   // Pen position on the baseline
+	size       float64 // Font size scaled by the text matrix and CTM
+	spaceWidth float64 // Width of a space; zero without metrics
+	vertical   bool    // Vertical writing mode
+}
+
+// BBox returns the axis-aligned bounding box of the glyph's quad.
+func (g Glyph) BBox() Rectangle {
+	return quadBox(g.Quad)
+}
+
+// ExtractGlyphs interprets the page like ExtractText and returns one record
+// per character code shown, in content stream order.
+func (e *Extractor) ExtractGlyphs() ([]Glyph, error) {
+	var glyphs []Glyph
+	sink := e.opts.GlyphSink
+	e.opts.GlyphSink = func(g Glyph) {
+		glyphs = append(glyphs, g)
+		if sink != nil {
+			sink(g)
+		}
+	}
+	defer func() { e.opts.GlyphSink = sink }()
+
+	if _, err := e.ExtractText(); err != nil {
+		return nil, err
+	}
+	return glyphs, nil
+}
+
+// defaultFontBBox is used for fonts without a usable /FontBBox
+var defaultFontBBox = Rectangle{0, -200, 1000, 800}
+
+// emitGlyph passes a glyph to the sink. offset is where the glyph starts
+// along the writing direction and advance its displacement, both in text
+// space units from the start of the string; m is the text matrix of the
+// string's start concatenated with the CTM.
+func (e *Extractor) emitGlyph(m Matrix, text string, code []byte, offset, advance float64, vertical bool) {
+	font := e.textState.Font
+	fs := e.textState.FontSize
+	box := defaultFontBBox
+	if font != nil && font.FontBBox.Height() > 0 {
+		box = font.FontBBox
+	}
+
+	var ll, ur Point
+	if vertical {
+		// Vertical glyphs hang below their origin, centred on it
+		ll = Point{-fs / 2, offset + advance}
+		ur = Point{fs / 2, offset}
+	} else {
+		rise := e.textState.Rise
+		ll = Point{offset, box.LLY/1000*fs + rise}
+		ur = Point{offset + advance, box.URY/1000*fs + rise}
+	}
+
+	g := Glyph{
+		Text: text,
+		Code: append([]byte(nil), code...),
+		Quad: [4]Point{
+			m.transform(ll.X, ll.Y), m.transform(ur.X, ll.Y),
+			m.transform(ur.X, ur.Y), m.transform(ll.X, ur.Y),
+		},
+		FontSize:   fs,
+		RenderMode: e.textState.RenderMode,
+		ColorSpace: e.gState.FillColorSpace,
+		FillColor:  e.gState.FillColor,
+		OpIndex:    e.ops - 1,
+	}
+	if font != nil {
+		g.FontName = strings.TrimPrefix(font.BaseFont, "/")
+	}
+
+	g.vertical = vertical
+	if vertical {
+		g.origin = m.transform(0, offset)
+	} else {
+		g.origin = m.transform(offset, e.textState.Rise)
+	}
+	g.size = fs * math.Hypot(m[2], m[3])
+	if font != nil {
+		g.spaceWidth = font.SpaceWidth / 1000 * fs * e.textState.Scale / 100 * math.Hypot(m[0], m[1])
+	}
+	e.opts.GlyphSink(g)
+}
+
+// transform maps a point through the matrix
+func (a Matrix) transform(x, y float64) Point {
+	return Point{x*a[0] + y*a[2] + a[4], x*a[1] + y*a[3] + a[5]}
+}
+
+// setFillColorSpace handles cs: the color becomes the space's initial color
+func (e *Extractor) setFillColorSpace(name NameObject) {
+	space := string(name)
+	switch space {
+	case "/DeviceRGB", "/CalRGB":
+		e.gState.FillColor = []float64{0, 0, 0}
+	case "/DeviceCMYK":
+		e.gState.FillColor = []float64{0, 0, 0, 1}
+	case "/Pattern":
+		e.gState.FillColor = nil
+	default:
+		e.gState.FillColor = []float64{0}
+	}
+	e.gState.FillColorSpace = space
+}
+
+// setFillColor handles g, rg, k, sc and scn. A pattern name operand of scn
+// is not a color component and is skipped.
+func (e *Extractor) setFillColor(space string, operands []Object) {
+	color := make([]float64, 0, len(operands))
+	for _, o := range operands {
+		if n, ok := o.(NumberObject); ok {
+			color = append(color, float64(n))
+		}
+	}
+	if space != "" {
+		e.gState.FillColorSpace = space
+	}
+	e.gState.FillColor = color
+}
@@ -0,0 +1,370 @@
+package pdf
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AOShei/go-fast-pdf/pkg/model"
+)
+
+// Text segmentation.
+//
+// Words, lines and blocks are built from the glyphs of a page after they
+// have all been collected, so the result does not depend on the order in
+// which the content stream shows them. Glyphs are grouped by writing
+// direction and laid out in a frame where that direction runs along +u and
+// successive lines stack towards -v. The gap rules are those of handleText:
+// a gap wider than half a space starts a new word, and a baseline shift of
+// more than half the font size starts a new line.
+
+const (
+	lineBreakGap  = 2.0 // Gap between words, in font sizes, that splits a line (columns, table cells)
+	blockBreakGap = 0.8 // Gap between the first two lines of a block, in font sizes
+	blockGapSlack = 0.3 // Growth in line spacing, in font sizes, that ends a block
+	blockSizeDiff = 1.3 // Ratio of font sizes that ends a block
+)
+
+// Layout is the segmentation of a page's text into words, lines and blocks.
+type Layout struct {
+	Words  []model.TextSpan
+	Lines  []model.TextSpan
+	Blocks []model.TextSpan
+	Tables []model.Table // Filled in by DetectTables
+
+	blocks []*textBlock
+	tables []*tableRegion
+}
+
+// frame maps page space to the u/v frame of a writing direction
+type frame struct {
+	cos, sin float64
+	vertical bool // Top to bottom columns, right to left
+}
+
+func (f frame) local(p Point) Point {
+	if f.vertical {
+		return Point{-p.Y, p.X}
+	}
+	return Point{p.X*f.cos + p.Y*f.sin, -p.X*f.sin + p.Y*f.cos}
+}
+
+// layoutGlyph is a glyph placed in its frame
+type layoutGlyph struct {
+	*Glyph
+	box   Rectangle // In the frame
+	base  float64   // Baseline v
+	space bool      // Whitespace: separates words but is not part of one
+}
+
+type textWord struct {
+	glyphs []*layoutGlyph
+	box    Rectangle
+}
+
+type textLine struct {
+	words []*textWord
+	box   Rectangle
+	size  float64
+	row   int // Index of the baseline it sits on; lines split by wide gaps share it
+}
+
+type textBlock struct {
+	lines []*textLine
+	box   Rectangle
+	gap   float64 // Between the last two lines; negative infinity for one line
+	dir   int     // Writing direction, as returned by glyphFrame
+}
+
+// AnalyzeLayout segments the glyphs of a page, as returned by ExtractGlyphs,
+// into words, lines and blocks. Lines are in reading order within each
+// writing direction: top to bottom, then left to right. Blocks are ordered
+// by their first line.
+func AnalyzeLayout(glyphs []Glyph) *Layout {
+	// Group by writing direction, horizontal text first
+	groups := make(map[int][]*layoutGlyph)
+	for i := range glyphs {
+		g := &glyphs[i]
+		if g.Text == "" {
+			continue
+		}
+		key, f := glyphFrame(g)
+		groups[key] = append(groups[key], placeGlyph(g, f))
+	}
+	keys := make([]int, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	layout := &Layout{}
+	for _, key := range keys {
+		lines := buildLines(groups[key])
+		blocks := buildBlocks(lines)
+		for _, b := range blocks {
+			b.dir = key
+		}
+		for _, line := range lines {
+			for _, w := range line.words {
+				layout.Words = append(layout.Words, span(w.glyphs, wordText(w)))
+			}
+			layout.Lines = append(layout.Lines, span(lineGlyphs(line), lineText(line)))
+		}
+		for _, b := range blocks {
+			layout.Blocks = append(layout.Blocks, b.span())
+		}
+		layout.blocks = append(layout.blocks, blocks...)
+	}
+	return layout
+}
+
+// glyphFrame returns the writing direction of a glyph: its angle in whole
+// degrees, or 360 for vertical writing
+func glyphFrame(g *Glyph) (int, frame) {
+	if g.vertical {
+		return 360, frame{vertical: true}
+	}
+	dx, dy := g.Quad[1].X-g.Quad[0].X, g.Quad[1].Y-g.Quad[0].Y
+	if dx == 0 && dy == 0 {
+		// Zero width glyph: fall back to the glyph's up direction
+		dx, dy = g.Quad[3].Y-g.Quad[0].Y, -(g.Quad[3].X - g.Quad[0].X)
+	}
+	deg := int(math.Round(math.Atan2(dy, dx)*180/math.Pi)) % 360
+	if deg < 0 {
+		deg += 360
+	}
+	rad := float64(deg) * math.Pi / 180
+	return deg, frame{cos: math.Cos(rad), sin: math.Sin(rad)}
+}
+
+func placeGlyph(g *Glyph, f frame) *layoutGlyph {
+	lg := &layoutGlyph{Glyph: g, base: f.local(g.origin).Y}
+	for i, p := range g.Quad {
+		q := f.local(p)
+		if i == 0 {
+			lg.box = Rectangle{q.X, q.Y, q.X, q.Y}
+			continue
+		}
+		lg.box.LLX, lg.box.URX = min(lg.box.LLX, q.X), max(lg.box.URX, q.X)
+		lg.box.LLY, lg.box.URY = min(lg.box.LLY, q.Y), max(lg.box.URY, q.Y)
+	}
+	lg.space = strings.TrimFunc(g.Text, unicode.IsSpace) == ""
+	return lg
+}
+
+// wordGap is the gap after which a glyph starts a new word
+func (g *layoutGlyph) wordGap() float64 {
+	if g.spaceWidth > 0 {
+		return g.spaceWidth * 0.5
+	}
+	return g.size * 0.2
+}
+
+// buildLines splits glyphs into word fragments, groups the fragments on the
+// same baseline into rows, merges touching fragments into words and splits
+// the rows into lines at wide gaps.
+func buildLines(glyphs []*layoutGlyph) []*textLine {
+	// Fragments: runs of glyphs that continue each other in content stream
+	// order. Text drawn over other text stays apart from it.
+	var frags []*textWord
+	var frag *textWord
+	var prev *layoutGlyph
+	for _, g := range glyphs {
+		if g.space {
+			frag = nil
+			continue
+		}
+		if frag != nil {
+			if g.Text == prev.Text && math.Abs(g.box.LLX-prev.box.LLX) < prev.box.Width()*0.3 &&
+				math.Abs(g.base-prev.base) < prev.size*0.1 {
+				continue // Drawn twice for a bold effect
+			}
+			gap := g.box.LLX - prev.box.URX
+			if math.Abs(g.base-prev.base) > math.Max(g.size, prev.size)*0.5 || math.Abs(gap) > g.wordGap() {
+				frag = nil
+			}
+		}
+		if frag == nil {
+			frag = &textWord{box: g.box}
+			frags = append(frags, frag)
+		}
+		frag.glyphs = append(frag.glyphs, g)
+		frag.box = union(frag.box, g.box)
+		prev = g
+	}
+
+	sort.SliceStable(frags, func(i, j int) bool { return frags[i].base() > frags[j].base() })
+	var rows [][]*textWord
+	var rowBase, rowSize float64
+	for _, f := range frags {
+		base, size := f.base(), dominantSize(f.glyphs)
+		n := len(rows)
+		if n > 0 && math.Abs(base-rowBase) <= math.Max(size, rowSize)*0.5 {
+			rows[n-1] = append(rows[n-1], f)
+			rowSize = math.Max(rowSize, size)
+			continue
+		}
+		rows = append(rows, []*textWord{f})
+		rowBase, rowSize = base, size
+	}
+
+	var lines []*textLine
+	for ri, row := range rows {
+		sort.SliceStable(row, func(i, j int) bool { return row[i].box.LLX < row[j].box.LLX })
+
+		var words []*textWord
+		var word *textWord
+		for _, f := range row {
+			first := f.glyphs[0]
+			if word != nil {
+				gap := f.box.LLX - word.box.URX
+				if gap >= -first.wordGap() && gap <= first.wordGap() {
+					word.glyphs = append(word.glyphs, f.glyphs...)
+					word.box = union(word.box, f.box)
+					continue
+				}
+			}
+			word = f
+			words = append(words, word)
+		}
+
+		var line *textLine
+		for _, w := range words {
+			size := dominantSize(w.glyphs)
+			if line == nil || w.box.LLX-line.box.URX > lineBreakGap*math.Max(size, line.size) {
+				line = &textLine{box: w.box, row: ri}
+				lines = append(lines, line)
+			}
+			line.words = append(line.words, w)
+			line.box = union(line.box, w.box)
+			line.size = math.Max(line.size, size)
+		}
+	}
+	for _, line := range lines {
+		line.size = dominantSize(lineGlyphs(line))
+	}
+	return lines
+}
+
+// base returns the baseline of the word's first glyph
+func (w *textWord) base() float64 {
+	return w.glyphs[0].base
+}
+
+// buildBlocks stacks lines into blocks. A line joins the block whose last
+// line is just above it, overlaps it horizontally, has a similar font size
+// and keeps the block's line spacing.
+func buildBlocks(lines []*textLine) []*textBlock {
+	var blocks []*textBlock
+	for _, line := range lines {
+		var best *textBlock
+		bestGap := math.Inf(1)
+		for _, b := range blocks {
+			last := b.lines[len(b.lines)-1]
+			gap := last.box.LLY - line.box.URY
+			size := math.Max(last.size, line.size)
+			if gap < -size || gap >= bestGap ||
+				line.box.URX <= last.box.LLX || line.box.LLX >= last.box.URX ||
+				size > blockSizeDiff*math.Min(last.size, line.size) {
+				continue
+			}
+			maxGap := blockBreakGap * size
+			if len(b.lines) > 1 {
+				maxGap = b.gap + blockGapSlack*size
+			}
+			if gap <= maxGap {
+				best, bestGap = b, gap
+			}
+		}
+		if best == nil {
+			blocks = append(blocks, &textBlock{lines: []*textLine{line}, box: line.box, gap: math.Inf(-1)})
+			continue
+		}
+		best.lines = append(best.lines, line)
+		best.box = union(best.box, line.box)
+		best.gap = bestGap
+	}
+	return blocks
+}
+
+func (b *textBlock) span() model.TextSpan {
+	var glyphs []*layoutGlyph
+	texts := make([]string, len(b.lines))
+	for i, line := range b.lines {
+		glyphs = append(glyphs, lineGlyphs(line)...)
+		texts[i] = lineText(line)
+	}
+	return span(glyphs, strings.Join(texts, "\n"))
+}
+
+func lineGlyphs(line *textLine) []*layoutGlyph {
+	var glyphs []*layoutGlyph
+	for _, w := range line.words {
+		glyphs = append(glyphs, w.glyphs...)
+	}
+	return glyphs
+}
+
+func wordText(w *textWord) string {
+	var sb strings.Builder
+	for _, g := range w.glyphs {
+		sb.WriteString(g.Text)
+	}
+	return sb.String()
+}
+
+func lineText(line *textLine) string {
+	words := make([]string, len(line.words))
+	for i, w := range line.words {
+		words[i] = wordText(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// span describes glyphs as a model.TextSpan with a page space bounding box
+// and the font that shows the most characters
+func span(glyphs []*layoutGlyph, text string) model.TextSpan {
+	type fontKey struct {
+		name string
+		size float64
+	}
+	counts := make(map[fontKey]int)
+	var best fontKey
+	var box Rectangle
+	for i, g := range glyphs {
+		if i == 0 {
+			box = g.BBox()
+		} else {
+			box = union(box, g.BBox())
+		}
+		key := fontKey{g.FontName, math.Round(g.size*100) / 100}
+		counts[key] += utf8.RuneCountInString(g.Text)
+		if counts[key] > counts[best] {
+			best = key
+		}
+	}
+	return model.TextSpan{Text: text, BBox: box.Array(), Font: best.name, FontSize: best.size}
+}
+
+// dominantSize returns the font size that shows the most glyphs
+func dominantSize(glyphs []*layoutGlyph) float64 {
+	counts := make(map[float64]int)
+	best := 0.0
+	for _, g := range glyphs {
+		size := math.Round(g.size*100) / 100
+		counts[size]++
+		if counts[size] > counts[best] {
+			best = size
+		}
+	}
+	return best
+}
+
+func union(a, b Rectangle) Rectangle {
+	return Rectangle{
+		LLX: math.Min(a.LLX, b.LLX), LLY: math.Min(a.LLY, b.LLY),
+		URX: math.Max(a.URX, b.URX), URY: math.Max(a.URY, b.URY),
+	}
+}
@@ -13,15 +13,28 @@ import (
 type Lexer struct {
 	reader *bufio.Reader
 	seeker io.Seeker
+
+	depth    int // Current array/dictionary nesting
+	maxDepth int // Negative for no limit
 }
 
 func NewLexer(r io.ReadSeeker) *Lexer {
 	return &Lexer{
-		reader: bufio.NewReader(r),
-		seeker: r,
+		reader:   bufio.NewReader(r),
+		seeker:   r,
+		maxDepth: defaultLimits.MaxNestingDepth,
 	}
 }
 
+// enter tracks nesting into an array or dictionary
+func (l *Lexer) enter() error {
+	l.depth++
+	if l.maxDepth >= 0 && l.depth > l.maxDepth {
+		return &LimitError{Limit: "MaxNestingDepth", Max: int64(l.maxDepth)}
+	}
+	return nil
+}
+
 // ReadObject parses the next object from the stream.
 func (l *Lexer) ReadObject() (Object, error) {
 	l.skipWhitespace()
@@ -329,10 +342,17 @@ func (l *Lexer) readTokenString() (string, error) {
 
 func (l *Lexer) readArray() (ArrayObject, error) {
 	l.reader.ReadByte() // consume '['
+	defer func() { l.depth-- }()
+	if err := l.enter(); err != nil {
+		return nil, err
+	}
 	var arr ArrayObject
 	for {
 		l.skipWhitespace()
 		b, _ := l.reader.Peek(1)
+		if len(b) == 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
 		if b[0] == ']' {
 			l.reader.ReadByte()
 			break
@@ -349,6 +369,10 @@ func (l *Lexer) readArray() (ArrayObject, error) {
 func (l *Lexer) readDictionary() (DictionaryObject, error) {
 	l.reader.ReadByte()
 	l.reader.ReadByte() // consume <<
+	defer func() { l.depth-- }()
+	if err := l.enter(); err != nil {
+		return nil, err
+	}
 	dict := make(DictionaryObject)
 	for {
 		l.skipWhitespace()
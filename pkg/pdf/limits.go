@@ -0,0 +1,153 @@
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// Resource limits for untrusted input.
+//
+// A few kilobytes of PDF can describe gigabytes of decoded data (nested
+// Flate streams), unbounded nesting or endless content streams. The limits
+// below bound what a Reader will do; exceeding one is a *LimitError.
+
+// Default limits, used for the zero values in Limits
+const (
+	DefaultMaxStreamSize   = 256 << 20 // Decoded bytes per stream
+	DefaultMaxTotalDecoded = 1 << 30   // Decoded bytes per document
+	DefaultMaxNestingDepth = 256       // Array and dictionary nesting
+	DefaultMaxContentOps   = 10000000  // Operators per page, forms included
+	DefaultMaxXRefEntries  = 8388607   // The PDF implementation limit on objects
+)
+
+// ErrLimitExceeded is matched by every *LimitError.
+var ErrLimitExceeded = errors.New("pdf: resource limit exceeded")
+
+// LimitError reports which limit a document exceeded.
+type LimitError struct {
+	Limit string // Name of the Limits field, e.g. "MaxStreamSize"
+	Max   int64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("pdf: %s limit of %d exceeded", e.Limit, e.Max)
+}
+
+// Unwrap makes errors.Is(err, ErrLimitExceeded) true for any LimitError.
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// Limits bounds the resources a Reader spends on a document. Zero selects
+// the Default* value and a negative value disables the limit.
+type Limits struct {
+	MaxStreamSize   int64 // Decoded bytes per stream
+	MaxTotalDecoded int64 // Decoded bytes across all streams of the document
+	MaxNestingDepth int   // Nesting of arrays and dictionaries in an object
+	MaxContentOps   int   // Operators interpreted for a page, forms included
+	MaxXRefEntries  int   // Cross-reference entries
+}
+
+// withDefaults replaces zero limits with the defaults
+func (l Limits) withDefaults() Limits {
+	if l.MaxStreamSize == 0 {
+		l.MaxStreamSize = DefaultMaxStreamSize
+	}
+	if l.MaxTotalDecoded == 0 {
+		l.MaxTotalDecoded = DefaultMaxTotalDecoded
+	}
+	if l.MaxNestingDepth == 0 {
+		l.MaxNestingDepth = DefaultMaxNestingDepth
+	}
+	if l.MaxContentOps == 0 {
+		l.MaxContentOps = DefaultMaxContentOps
+	}
+	if l.MaxXRefEntries == 0 {
+		l.MaxXRefEntries = DefaultMaxXRefEntries
+	}
+	return l
+}
+
+// DecodeCounter counts decoded stream bytes for MaxTotalDecoded. It is safe
+// for concurrent use; see ReaderOptions.Decoded.
+type DecodeCounter struct {
+	n atomic.Int64
+}
+
+// Load returns the number of bytes counted so far.
+func (c *DecodeCounter) Load() int64 {
+	return c.n.Load()
+}
+
+// defaultLimits apply to parsers created outside a Reader
+var defaultLimits = Limits{}.withDefaults()
+
+// streamBudget returns how many decoded bytes the next stream may produce
+// (negative for no limit) and the error for going over it.
+func (r *Reader) streamBudget() (int64, *LimitError) {
+	return r.limits.streamBudget(r.decoded.Load())
+}
+
+// streamBudget is Reader.streamBudget for a document that has decoded
+// decoded bytes so far
+func (l Limits) streamBudget(decoded int64) (int64, *LimitError) {
+	budget := l.MaxStreamSize
+	exceeded := &LimitError{Limit: "MaxStreamSize", Max: l.MaxStreamSize}
+	if l.MaxTotalDecoded >= 0 {
+		left := l.MaxTotalDecoded - decoded
+		if left < 0 {
+			left = 0
+		}
+		if budget < 0 || left < budget {
+			budget = left
+			exceeded = &LimitError{Limit: "MaxTotalDecoded", Max: l.MaxTotalDecoded}
+		}
+	}
+	return budget, exceeded
+}
+
+// addDecoded counts decoded bytes against MaxTotalDecoded
+func (r *Reader) addDecoded(n int) error {
+	decoded := r.decoded.n.Add(int64(n))
+	if r.limits.MaxTotalDecoded >= 0 && decoded > r.limits.MaxTotalDecoded {
+		return &LimitError{Limit: "MaxTotalDecoded", Max: r.limits.MaxTotalDecoded}
+	}
+	return nil
+}
+
+// limitReader counts the bytes read from rd against the decoded size limits
+func (r *Reader) limitReader(rd io.Reader) io.Reader {
+	budget, exceeded := r.streamBudget()
+	return &limitedReader{r: rd, reader: r, left: budget, exceeded: exceeded}
+}
+
+// limitedReader fails with a *LimitError once more than left bytes are read
+type limitedReader struct {
+	r        io.Reader
+	reader   *Reader
+	left     int64 // Negative for no limit
+	exceeded *LimitError
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	if l.left >= 0 {
+		if int64(n) > l.left {
+			return 0, l.exceeded
+		}
+		l.left -= int64(n)
+	}
+	if addErr := l.reader.addDecoded(n); addErr != nil {
+		return n, addErr
+	}
+	return n, err
+}
+
+// isFatal reports whether an error reading an object must be returned to
+// the caller rather than recorded as a warning
+func isFatal(err error) bool {
+	var unsupported *UnsupportedFilterError
+	return errors.Is(err, ErrLimitExceeded) || errors.As(err, &unsupported)
+}
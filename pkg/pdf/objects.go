@@ -1,8 +1,11 @@
 package pdf
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 )
 
 // Object is the generic interface for all PDF objects.
@@ -87,13 +90,92 @@ func (i IndirectObject) String() string {
 }
 
 // StreamObject represents a dictionary followed by binary stream data.
+// A stream read from a file only holds its dictionary and where its data
+// is; the data is read and decoded on the first call to Data or Reader.
 type StreamObject struct {
 	Dictionary DictionaryObject
-	Data       []byte
+	body       *streamBody
+}
+
+// streamBody holds the data of a stream, loaded at most once
+type streamBody struct {
+	mu     sync.Mutex
+	loaded bool
+	data   []byte
+	err    error
+
+	load   func() ([]byte, error)          // Reads and decodes the whole stream
+	open   func() (io.Reader, bool, error) // Decodes while reading, if the stream allows it
+	onLoad func(n int)                     // Told the size of the data once loaded
+}
+
+// NewStreamObject returns a stream with already decoded data.
+func NewStreamObject(dict DictionaryObject, data []byte) StreamObject {
+	return StreamObject{Dictionary: dict, body: &streamBody{loaded: true, data: data}}
+}
+
+// Data returns the decoded stream data, reading and decoding it on the
+// first call. The result is kept for later calls.
+func (s StreamObject) Data() ([]byte, error) {
+	if s.body == nil {
+		return nil, nil
+	}
+	s.body.mu.Lock()
+	defer s.body.mu.Unlock()
+	if !s.body.loaded {
+		s.body.data, s.body.err = s.body.load()
+		s.body.loaded = true
+		if s.body.onLoad != nil && s.body.err == nil {
+			s.body.onLoad(len(s.body.data))
+		}
+		s.body.load, s.body.open, s.body.onLoad = nil, nil, nil
+	}
+	return s.body.data, s.body.err
+}
+
+// Reader returns the decoded stream data as a reader. Unencrypted streams
+// that are stored raw or with /FlateDecode alone are decoded while being
+// read, without holding the whole stream in memory; others are loaded as
+// by Data.
+func (s StreamObject) Reader() (io.Reader, error) {
+	if s.body != nil {
+		s.body.mu.Lock()
+		open := s.body.open
+		if s.body.loaded {
+			open = nil
+		}
+		s.body.mu.Unlock()
+		if open != nil {
+			if rd, ok, err := open(); ok || err != nil {
+				return rd, err
+			}
+		}
+	}
+	data, err := s.Data()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// detached returns a copy of an unloaded stream with a body of its own, so
+// that loading the copy does not keep the data in s.
+func (s StreamObject) detached() StreamObject {
+	if s.body == nil || s.body.loaded {
+		return s
+	}
+	return StreamObject{Dictionary: s.Dictionary, body: &streamBody{load: s.body.load, open: s.body.open}}
 }
 
 func (s StreamObject) String() string {
-	return fmt.Sprintf("Stream(len=%d)", len(s.Data))
+	if s.body != nil {
+		s.body.mu.Lock()
+		defer s.body.mu.Unlock()
+		if s.body.loaded {
+			return fmt.Sprintf("Stream(len=%d)", len(s.body.data))
+		}
+	}
+	return "Stream"
 }
 
 // KeywordObject represents raw keywords (e.g., obj, stream, Tj).
@@ -0,0 +1,243 @@
+package pdf
+
+import "math"
+
+// Page attributes that a leaf /Page inherits from its /Pages ancestors
+// when it does not define them itself (PDF 32000-1 7.7.3.4).
+var inheritableAttrs = []string{"/Resources", "/MediaBox", "/CropBox", "/Rotate"}
+
+// inheritedAttrs returns the inheritable attributes defined on a page tree node
+func inheritedAttrs(node DictionaryObject) DictionaryObject {
+	attrs := DictionaryObject{}
+	for _, key := range inheritableAttrs {
+		if v, ok := node[key]; ok {
+			attrs[key] = v
+		}
+	}
+	return attrs
+}
+
+// withInherited returns a copy of dict with the inherited attributes it does
+// not define itself filled in. dict is returned unchanged if nothing is added.
+func withInherited(dict, inherited DictionaryObject) DictionaryObject {
+	var out DictionaryObject
+	for key, v := range inherited {
+		if _, ok := dict[key]; ok {
+			continue
+		}
+		if out == nil {
+			out = make(DictionaryObject, len(dict)+len(inherited))
+			for k, dv := range dict {
+				out[k] = dv
+			}
+		}
+		out[key] = v
+	}
+	if out == nil {
+		return dict
+	}
+	return out
+}
+
+// PageRef locates a leaf of the page tree.
+type PageRef struct {
+	Ref       IndirectObject   // The /Page object
+	Inherited DictionaryObject // Inheritable attributes from its /Pages ancestors
+
+	dict DictionaryObject // Set instead of Ref for a page stored as a direct object
+}
+
+// Pages returns the leaves of the page tree in document order. The tree is
+// walked once per Reader; /Kids entries that do not resolve to dictionaries
+// and references back into the tree are skipped. If the walk finds fewer
+// pages than the root /Count claims, every /Type /Page object in the file is
+// collected instead, in object number order.
+func (r *Reader) Pages() []PageRef {
+	r.pagesOnce.Do(func() {
+		var root Object
+		if catDict, ok := r.Resolve(r.xref.Trailer["/Root"]).(DictionaryObject); ok {
+			root = catDict["/Pages"]
+		}
+		visited := make(map[int]bool)
+		r.collectPages(root, DictionaryObject{}, visited, &r.pages)
+
+		count := 0
+		if rootDict, ok := r.Resolve(root).(DictionaryObject); ok {
+			if n, ok := r.Resolve(rootDict["/Count"]).(NumberObject); ok {
+				count = int(n)
+			}
+		}
+		if len(r.pages) == 0 || len(r.pages) < count {
+			if scanned := r.scanPages(); len(scanned) > len(r.pages) {
+				r.pages = scanned
+			}
+		}
+	})
+	return r.pages
+}
+
+// collectPages appends the leaves below a page tree node to out
+func (r *Reader) collectPages(node Object, inherited DictionaryObject, visited map[int]bool, out *[]PageRef) {
+	ref, isRef := node.(IndirectObject)
+	if isRef {
+		if visited[ref.ObjectNumber] {
+			return
+		}
+		visited[ref.ObjectNumber] = true
+	}
+	dict, ok := r.Resolve(node).(DictionaryObject)
+	if !ok {
+		return
+	}
+
+	nodeType, _ := dict["/Type"].(NameObject)
+	kids, hasKids := r.Resolve(dict["/Kids"]).(ArrayObject)
+	if nodeType == "/Page" || (nodeType != "/Pages" && !hasKids) {
+		page := PageRef{Ref: ref, Inherited: inherited}
+		if !isRef {
+			page.dict = dict
+		}
+		*out = append(*out, page)
+		return
+	}
+
+	inherited = withInherited(inheritedAttrs(dict), inherited)
+	for _, kid := range kids {
+		r.collectPages(kid, inherited, visited, out)
+	}
+}
+
+// scanPages finds every /Type /Page object in the cross-reference table,
+// taking inherited attributes from its /Parent chain.
+func (r *Reader) scanPages() []PageRef {
+	var pages []PageRef
+	for _, num := range r.xref.objectNumbers() {
+		ref := IndirectObject{ObjectNumber: num, Generation: r.xref.Entries[num].Generation}
+		dict, ok := r.Resolve(ref).(DictionaryObject)
+		if !ok {
+			continue
+		}
+		if t, _ := dict["/Type"].(NameObject); t != "/Page" {
+			continue
+		}
+		pages = append(pages, PageRef{Ref: ref, Inherited: r.parentAttrs(dict)})
+	}
+	return pages
+}
+
+// parentAttrs collects inheritable attributes up the /Parent chain of a page
+func (r *Reader) parentAttrs(page DictionaryObject) DictionaryObject {
+	inherited := DictionaryObject{}
+	visited := make(map[int]bool)
+	parent := page["/Parent"]
+	for {
+		ref, ok := parent.(IndirectObject)
+		if !ok || visited[ref.ObjectNumber] {
+			return inherited
+		}
+		visited[ref.ObjectNumber] = true
+		dict, ok := r.Resolve(ref).(DictionaryObject)
+		if !ok {
+			return inherited
+		}
+		// Nearer ancestors take precedence
+		inherited = withInherited(inherited, inheritedAttrs(dict))
+		parent = dict["/Parent"]
+	}
+}
+
+// Rectangle is a PDF rectangle normalised so that LLX <= URX and LLY <= URY.
+type Rectangle struct {
+	LLX, LLY, URX, URY float64
+}
+
+// Width returns the horizontal extent of the rectangle.
+func (r Rectangle) Width() float64 { return r.URX - r.LLX }
+
+// Height returns the vertical extent of the rectangle.
+func (r Rectangle) Height() float64 { return r.URY - r.LLY }
+
+// Array returns the rectangle as [llx lly urx ury].
+func (r Rectangle) Array() []float64 { return []float64{r.LLX, r.LLY, r.URX, r.URY} }
+
+// intersect clips r to other. The result is empty (zero size) when they do not overlap.
+func (r Rectangle) intersect(other Rectangle) Rectangle {
+	out := Rectangle{
+		LLX: math.Max(r.LLX, other.LLX), LLY: math.Max(r.LLY, other.LLY),
+		URX: math.Min(r.URX, other.URX), URY: math.Min(r.URY, other.URY),
+	}
+	if out.URX < out.LLX {
+		out.URX = out.LLX
+	}
+	if out.URY < out.LLY {
+		out.URY = out.LLY
+	}
+	return out
+}
+
+// PageGeometry describes the boxes and orientation of a page.
+type PageGeometry struct {
+	MediaBox Rectangle
+	CropBox  Rectangle // Defaults to the MediaBox, clipped to it
+	Rotate   int       // Clockwise display rotation: 0, 90, 180 or 270
+	UserUnit float64   // Size of a default user space unit in 1/72 inch
+}
+
+// defaultMediaBox is US Letter, used when a page has no usable /MediaBox
+var defaultMediaBox = Rectangle{0, 0, 612, 792}
+
+// PageGeometry reads the /MediaBox, /CropBox, /Rotate and /UserUnit of a
+// page returned by GetPage.
+func (r *Reader) PageGeometry(page DictionaryObject) PageGeometry {
+	g := PageGeometry{MediaBox: defaultMediaBox, UserUnit: 1}
+	if box, ok := r.rectangle(page["/MediaBox"]); ok {
+		g.MediaBox = box
+	}
+	g.CropBox = g.MediaBox
+	if box, ok := r.rectangle(page["/CropBox"]); ok {
+		g.CropBox = box.intersect(g.MediaBox)
+	}
+
+	// /Rotate must be a multiple of 90; anything else is rounded to one
+	if rot, ok := r.Resolve(page["/Rotate"]).(NumberObject); ok {
+		deg := int(math.Round(float64(rot)/90)) * 90 % 360
+		if deg < 0 {
+			deg += 360
+		}
+		g.Rotate = deg
+	}
+	if uu, ok := r.Resolve(page["/UserUnit"]).(NumberObject); ok && uu > 0 {
+		g.UserUnit = float64(uu)
+	}
+	return g
+}
+
+// VisualSize returns the width and height of the page as displayed: the
+// crop box, swapped for quarter-turn rotations and scaled by UserUnit.
+func (g PageGeometry) VisualSize() (width, height float64) {
+	width, height = g.CropBox.Width(), g.CropBox.Height()
+	if g.Rotate == 90 || g.Rotate == 270 {
+		width, height = height, width
+	}
+	return width * g.UserUnit, height * g.UserUnit
+}
+
+// rectangle reads a rectangle array, resolving indirect elements
+func (r *Reader) rectangle(obj Object) (Rectangle, bool) {
+	arr, ok := r.Resolve(obj).(ArrayObject)
+	if !ok || len(arr) != 4 {
+		return Rectangle{}, false
+	}
+	var v [4]float64
+	for i, o := range arr {
+		n, ok := r.Resolve(o).(NumberObject)
+		if !ok {
+			return Rectangle{}, false
+		}
+		v[i] = float64(n)
+	}
+	return Rectangle{
+		LLX: math.Min(v[0], v[2]), LLY: math.Min(v[1], v[3]),
+		URX: math.Max(v[0], v[2]), URY: math.Max(v[1], v[3]),
+	}, true
+}
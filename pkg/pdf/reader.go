@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"sync"
+
+	"github.com/AOShei/go-fast-pdf/pkg/model"
 )
 
 // Reader is the high-level entry point for reading a PDF.
@@ -17,27 +19,68 @@ type Reader struct {
 	encryptHandler *EncryptionHandler
 
 	// Object cache for performance
-	mu          sync.RWMutex
-	objectCache map[int]Object
+	cache *objectCache
+
+	// Flattened page tree, built on first use
+	pagesOnce sync.Once
+	pages     []PageRef
+
+	// Problems recovered from while reading
+	warnMu   sync.Mutex
+	warnings []string
+
+	limits  Limits
+	decoded *DecodeCounter // Decoded stream bytes so far, for MaxTotalDecoded
+}
+
+// ReaderOptions configures how a document is opened.
+type ReaderOptions struct {
+	// Password is tried as both the user and the owner password of an
+	// encrypted document. Empty means the empty user password.
+	Password string
+
+	// Limits for untrusted input; exceeding one is a *LimitError.
+	Limits Limits
+
+	// Cache bounds the cache of resolved objects.
+	Cache CacheOptions
 
-	// Font cache for performance
-	fontCacheMu sync.RWMutex
-	fontCache   map[int]*Font
+	// Decoded, if set, counts the decoded bytes against MaxTotalDecoded.
+	// Readers given the same counter share one budget, e.g. several Readers
+	// of one document used concurrently. Nil gives the Reader its own.
+	Decoded *DecodeCounter
 }
 
+// NewReader opens a document with default options.
 func NewReader(rs io.ReadSeeker) (*Reader, error) {
+	return NewReaderWithOptions(rs, ReaderOptions{})
+}
+
+// NewReaderWithOptions opens a document using the given options.
+// Returns ErrWrongPassword if the document is encrypted and the
+// password is neither its user nor its owner password.
+func NewReaderWithOptions(rs io.ReadSeeker, opts ReaderOptions) (*Reader, error) {
+	lim := opts.Limits.withDefaults()
+
 	// 1. Parse XRef
-	xref, err := ParseXRef(rs)
+	xref, err := parseXRef(rs, lim)
 	if err != nil {
 		return nil, err
 	}
 
 	reader := &Reader{
-		rs:          rs,
-		xref:        xref,
-		lexer:       NewLexer(rs),
-		objectCache: make(map[int]Object),
-		fontCache:   make(map[int]*Font),
+		rs:      rs,
+		xref:    xref,
+		lexer:   NewLexer(rs),
+		cache:   newObjectCache(opts.Cache),
+		limits:  lim,
+		decoded: opts.Decoded,
+	}
+	if reader.decoded == nil {
+		reader.decoded = new(DecodeCounter)
+	}
+	if err := reader.addDecoded(int(xref.decoded)); err != nil {
+		return nil, err
 	}
 
 	// 2. Check for encryption and initialize handler
@@ -59,7 +102,7 @@ func NewReader(rs io.ReadSeeker) (*Reader, error) {
 			return nil, fmt.Errorf("failed to parse encryption: %w", err)
 		}
 
-		handler, err := NewEncryptionHandler(encDict, fileID)
+		handler, err := NewEncryptionHandlerWithPassword(encDict, fileID, []byte(opts.Password))
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize encryption: %w", err)
 		}
@@ -67,44 +110,70 @@ func NewReader(rs io.ReadSeeker) (*Reader, error) {
 		reader.encryptHandler = handler
 	}
 
+	// 3. A rebuilt table still lacks the objects inside object streams
+	if xref.Repaired {
+		reader.indexObjectStreams()
+		if !xref.validRoot() && !reader.findCatalog() {
+			return nil, errors.New("invalid PDF: no document catalog found")
+		}
+	}
+
 	return reader, nil
 }
 
+// Warnings returns the problems the reader has recovered from so far,
+// such as stream lengths corrected by searching for endstream.
+func (r *Reader) Warnings() []string {
+	r.warnMu.Lock()
+	defer r.warnMu.Unlock()
+	return append([]string(nil), r.warnings...)
+}
+
+func (r *Reader) warn(format string, args ...interface{}) {
+	r.warnMu.Lock()
+	r.warnings = append(r.warnings, fmt.Sprintf(format, args...))
+	r.warnMu.Unlock()
+}
+
+// Repaired reports whether the cross-reference table was rebuilt by
+// scanning the file because it was missing or damaged.
+func (r *Reader) Repaired() bool {
+	return r.xref.Repaired
+}
+
 // GetObject resolves an indirect reference to the actual object.
 func (r *Reader) GetObject(ref IndirectObject) (Object, error) {
 	// Check cache first
-	r.mu.RLock()
-	if cached, ok := r.objectCache[ref.ObjectNumber]; ok {
-		r.mu.RUnlock()
+	key := objKey{num: ref.ObjectNumber, gen: ref.Generation}
+	if cached, ok := r.cache.get(key); ok {
 		return cached, nil
 	}
-	r.mu.RUnlock()
 
 	entry, ok := r.xref.Entries[ref.ObjectNumber]
 	if !ok {
 		return nil, fmt.Errorf("object %d not found in xref", ref.ObjectNumber)
 	}
 
-	if entry.Free {
+	// A reference to another generation of the object is to an object that
+	// no longer exists, which is null. Compressed objects are generation 0.
+	if entry.Free || ref.Generation != entry.Generation {
 		return NullObject{}, nil
 	}
 
 	// Check if object is in a compressed stream
 	if entry.Compressed {
 		obj, err := r.getCompressedObject(entry.StreamObj, entry.StreamIdx)
-		if err == nil {
-			// Cache the object
-			r.mu.Lock()
-			r.objectCache[ref.ObjectNumber] = obj
-			r.mu.Unlock()
+		if err != nil {
+			return nil, err
 		}
-		return obj, err
+		return r.cache.add(key, obj), nil
 	}
 
 	// Jump to offset
 	r.rs.Seek(entry.Offset, io.SeekStart)
 
 	lexer := NewLexer(r.rs)
+	lexer.maxDepth = r.limits.MaxNestingDepth
 
 	// Consume "ObjNum Gen obj" header
 	lexer.ReadObject() // ID
@@ -122,7 +191,11 @@ func (r *Reader) GetObject(ref IndirectObject) (Object, error) {
 		lexer.skipWhitespace()
 		peek, _ := lexer.reader.Peek(6)
 		if string(peek) == "stream" {
-			return r.readStream(dict, lexer, ref.ObjectNumber, ref.Generation)
+			stm, err := r.readStream(dict, lexer, ref.ObjectNumber, ref.Generation)
+			if err != nil {
+				return nil, err
+			}
+			return r.cache.add(key, stm), nil
 		}
 	}
 
@@ -131,29 +204,16 @@ func (r *Reader) GetObject(ref IndirectObject) (Object, error) {
 		obj = r.decryptObject(obj, ref.ObjectNumber, ref.Generation)
 	}
 
-	// Cache the object
-	r.mu.Lock()
-	r.objectCache[ref.ObjectNumber] = obj
-	r.mu.Unlock()
-
-	return obj, nil
+	return r.cache.add(key, obj), nil
 }
 
-// readStream handles reading and DECOMPRESSING the stream data
+// readStream records where the data of a stream starts. The data itself is
+// only read and decoded when the stream's Data or Reader is called.
 func (r *Reader) readStream(dict DictionaryObject, lexer *Lexer, objNum, genNum int) (StreamObject, error) {
-	// 1. Get Length
-	lengthObj := r.Resolve(dict["/Length"])
-	length := int64(0)
-	if n, ok := lengthObj.(NumberObject); ok {
-		length = int64(n)
-	} else {
-		return StreamObject{}, errors.New("stream length missing or invalid")
-	}
-
-	// 2. Consume "stream" keyword
+	// 1. Consume "stream" keyword
 	lexer.reader.Discard(6)
 
-	// 3. Consume STRICT EOL (CRLF or LF)
+	// 2. Consume STRICT EOL (CRLF or LF)
 	// PDF binary streams start immediately after the newline.
 	// We cannot use skipWhitespace() because it might eat binary data (e.g. 0x0A inside the stream).
 	b, err := lexer.reader.ReadByte()
@@ -173,18 +233,92 @@ func (r *Reader) readStream(dict DictionaryObject, lexer *Lexer, objNum, genNum
 		lexer.reader.UnreadByte()
 	}
 
-	// 4. Read Raw Compressed Data
-	data := make([]byte, length)
-
-	// FIX: Use lexer.reader, NOT r.rs.
-	// r.rs is the underlying file, which might be ahead of the buffer.
-	if _, err := io.ReadFull(lexer.reader, data); err != nil {
+	// The data is later read from r.rs at an absolute offset
+	pos, err := r.rs.Seek(0, io.SeekCurrent)
+	if err != nil {
 		return StreamObject{}, err
 	}
+	start := pos - int64(lexer.reader.Buffered())
+
+	body := &streamBody{}
+	var length struct {
+		once sync.Once
+		n    int64
+		err  error
+	}
+	rawLength := func() (int64, error) {
+		length.once.Do(func() { length.n, length.err = r.streamLength(dict, start, objNum) })
+		return length.n, length.err
+	}
+	body.load = func() ([]byte, error) {
+		n, err := rawLength()
+		if err != nil {
+			return nil, err
+		}
+		return r.loadStream(dict, start, n, objNum, genNum)
+	}
+	body.open = func() (io.Reader, bool, error) {
+		n, err := rawLength()
+		if err != nil {
+			return nil, false, err
+		}
+		return r.openStream(dict, start, n)
+	}
+	return StreamObject{Dictionary: dict, body: body}, nil
+}
 
-	// 4.5. Decrypt data BEFORE decompression (if encrypted)
+// streamLength returns the length of the raw stream data at start. A
+// missing or wrong /Length is replaced by searching for endstream.
+func (r *Reader) streamLength(dict DictionaryObject, start int64, objNum int) (int64, error) {
+	length := int64(-1)
+	if n, ok := r.Resolve(dict["/Length"]).(NumberObject); ok && n >= 0 {
+		length = int64(n)
+	}
+	if length < 0 || !r.endstreamAt(start+length) {
+		found, ok := r.findEndstream(start)
+		switch {
+		case ok:
+			if dict["/Length"] == nil {
+				r.warn("object %d: stream has no /Length, using %d from endstream", objNum, found)
+			} else {
+				r.warn("object %d: stream /Length %v is wrong, using %d from endstream", objNum, dict["/Length"], found)
+			}
+			length = found
+		case length >= 0:
+			r.warn("object %d: stream has no endstream, using /Length %d", objNum, length)
+		default:
+			return 0, errors.New("stream length missing or invalid and no endstream found")
+		}
+	}
+
+	// Up to the end of a truncated file
+	size, err := r.rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if length > size-start {
+		length = size - start
+	}
+	if max := r.limits.MaxStreamSize; max >= 0 && length > max {
+		return 0, &LimitError{Limit: "MaxStreamSize", Max: max}
+	}
+	return length, nil
+}
+
+// loadStream reads, decrypts and decodes the data of a stream
+func (r *Reader) loadStream(dict DictionaryObject, start, length int64, objNum, genNum int) ([]byte, error) {
+	// 1. Read Raw Compressed Data
+	if _, err := r.rs.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.rs, data); err != nil {
+		return nil, err
+	}
+
+	// 2. Decrypt data BEFORE decompression (if encrypted)
 	if r.encryptHandler != nil {
-		decrypted, err := r.encryptHandler.Decrypt(data, objNum, genNum)
+		decrypted, err := r.encryptHandler.DecryptStream(data, objNum, genNum, dict)
 		if err == nil {
 			data = decrypted
 		}
@@ -192,120 +326,121 @@ func (r *Reader) readStream(dict DictionaryObject, lexer *Lexer, objNum, genNum
 		// Decompression will likely fail, but we'll handle that gracefully
 	}
 
-	// 5. Decompress
-	finalData := data
-	filterObj := r.Resolve(dict["/Filter"])
-	filters := []string{}
-
-	if name, ok := filterObj.(NameObject); ok {
-		filters = append(filters, string(name))
-	} else if arr, ok := filterObj.(ArrayObject); ok {
-		for _, f := range arr {
-			if name, ok := f.(NameObject); ok {
-				filters = append(filters, string(name))
-			}
-		}
+	// 3. Decode filters. A stream that fails to decode keeps the data
+	// from before the failing filter; one with no decoder is an error.
+	finalData, err := r.decodeStream(dict, data)
+	if isFatal(err) {
+		return nil, err
+	} else if err != nil {
+		r.warn("object %d: %v", objNum, err)
+	}
+	if err := r.addDecoded(len(finalData)); err != nil {
+		return nil, err
 	}
+	return finalData, nil
+}
 
-	for _, f := range filters {
-		if f == "/FlateDecode" {
-			zr, err := zlib.NewReader(bytes.NewReader(finalData))
-			if err != nil {
-				// Don't fail completely on zlib error, return raw data so we can debug
-				// or maybe it wasn't compressed.
-				return StreamObject{Dictionary: dict, Data: finalData}, nil
-			}
-			decompressed, err := io.ReadAll(zr)
-			zr.Close()
-			if err == nil {
-				finalData = decompressed
-			}
+// openStream decodes a stream while it is read, straight from the file.
+// It reports false for streams that must be loaded whole: encrypted ones,
+// ones with filters other than a lone /FlateDecode without predictor, and
+// any when the file cannot be read at an offset.
+func (r *Reader) openStream(dict DictionaryObject, start, length int64) (io.Reader, bool, error) {
+	ra, ok := r.rs.(io.ReaderAt)
+	if !ok || r.encryptHandler != nil {
+		return nil, false, nil
+	}
+	section := io.NewSectionReader(ra, start, length)
+
+	var filter []Object
+	switch f := r.Resolve(dict["/Filter"]).(type) {
+	case nil, NullObject:
+	case NameObject:
+		filter = []Object{f}
+	case ArrayObject:
+		filter = f
+	default:
+		return nil, false, nil
+	}
+	if len(filter) == 0 {
+		return r.limitReader(section), true, nil
+	}
+	if len(filter) > 1 || r.Resolve(filter[0]) != NameObject("/FlateDecode") {
+		return nil, false, nil
+	}
+	if parms, ok := r.Resolve(dict["/DecodeParms"]).(DictionaryObject); ok {
+		if p, ok := r.Resolve(parms["/Predictor"]).(NumberObject); ok && p > 1 {
+			return nil, false, nil
 		}
 	}
-
-	return StreamObject{
-		Dictionary: dict,
-		Data:       finalData,
-	}, nil
+	zr, err := zlib.NewReader(section)
+	if err != nil {
+		return nil, true, err
+	}
+	return r.limitReader(zr), true, nil
 }
 
-// NumPages returns the total page count.
+// NumPages returns the number of pages in the page tree.
 func (r *Reader) NumPages() int {
-	catalog := r.Resolve(r.xref.Trailer["/Root"])
-	if catDict, ok := catalog.(DictionaryObject); ok {
-		pages := r.Resolve(catDict["/Pages"])
-		if pagesDict, ok := pages.(DictionaryObject); ok {
-			if count, ok := pagesDict["/Count"].(NumberObject); ok {
-				return int(count)
-			}
-		}
-	}
-	return 0
+	return len(r.Pages())
 }
 
 // GetPage returns the dictionary for the Nth page (0-indexed).
+// Attributes the page inherits from its ancestor /Pages nodes
+// (/Resources, /MediaBox, /CropBox, /Rotate) are merged into the result.
 func (r *Reader) GetPage(pageIndex int) (DictionaryObject, error) {
-	catalog := r.Resolve(r.xref.Trailer["/Root"])
-	catDict, ok := catalog.(DictionaryObject)
-	if !ok {
-		return nil, fmt.Errorf("catalog is not a dictionary")
-	}
-
-	rootPages := r.Resolve(catDict["/Pages"])
-	rootPagesDict, ok := rootPages.(DictionaryObject)
-	if !ok {
-		return nil, fmt.Errorf("root pages is not a dictionary")
+	pages := r.Pages()
+	if pageIndex < 0 || pageIndex >= len(pages) {
+		return nil, fmt.Errorf("page %d out of range [0, %d)", pageIndex, len(pages))
+	}
+	ref := pages[pageIndex]
+	dict := ref.dict
+	if dict == nil {
+		var ok bool
+		if dict, ok = r.Resolve(ref.Ref).(DictionaryObject); !ok {
+			return nil, fmt.Errorf("page %d (object %d) is not a dictionary", pageIndex, ref.Ref.ObjectNumber)
+		}
 	}
+	return withInherited(dict, ref.Inherited), nil
+}
 
-	return r.findPage(rootPagesDict, &pageIndex)
+// objectStream is a decoded object stream with the offsets of its objects
+type objectStream struct {
+	data    []byte
+	first   int   // Offset of the first object
+	offsets []int // Object offsets relative to first
 }
 
-func (r *Reader) findPage(node DictionaryObject, targetIndex *int) (DictionaryObject, error) {
-	nodeType := node["/Type"].String()
+func (r *Reader) getCompressedObject(streamObjNum int, index int) (Object, error) {
+	objStm, err := r.getObjectStream(streamObjNum)
+	if err != nil {
+		return nil, err
+	}
 
-	if nodeType == "/Page" {
-		if *targetIndex == 0 {
-			return node, nil
-		}
-		*targetIndex--
-		return nil, nil
-	}
-
-	kids := r.Resolve(node["/Kids"]).(ArrayObject)
-	for _, kidRef := range kids {
-		kid := r.Resolve(kidRef).(DictionaryObject)
-
-		if countObj, ok := kid["/Count"].(NumberObject); ok {
-			count := int(countObj)
-			if *targetIndex < count {
-				found, err := r.findPage(kid, targetIndex)
-				if err != nil {
-					return nil, err
-				}
-				if found != nil {
-					return found, nil
-				}
-			} else {
-				*targetIndex -= count
-			}
-		} else {
-			found, err := r.findPage(kid, targetIndex)
-			if err != nil {
-				return nil, err
-			}
-			if found != nil {
-				return found, nil
-			}
-		}
+	if index < 0 || index >= len(objStm.offsets) {
+		return nil, fmt.Errorf("object index %d out of bounds [0, %d)", index, len(objStm.offsets))
 	}
 
-	return nil, nil
+	startOffset := int64(objStm.first + objStm.offsets[index])
+	stmReader := bytes.NewReader(objStm.data)
+	stmReader.Seek(startOffset, io.SeekStart)
+
+	objLexer := NewLexer(stmReader)
+	objLexer.maxDepth = r.limits.MaxNestingDepth
+	return objLexer.ReadObject()
 }
 
-func (r *Reader) getCompressedObject(streamObjNum int, index int) (Object, error) {
+// getObjectStream decodes an object stream and reads its offsets once; the
+// result is cached whatever CacheOptions.ExcludeStreams says, as every
+// object in the stream needs it.
+func (r *Reader) getObjectStream(streamObjNum int) (*objectStream, error) {
+	key := objKey{num: streamObjNum, kind: kindObjStm}
+	if cached, ok := r.cache.getValue(key); ok {
+		return cached.(*objectStream), nil
+	}
+
 	// Get the object stream itself
 	// This calls GetObject -> readStream, so fixing readStream fixes this too.
-	objStream, err := r.GetObject(IndirectObject{ObjectNumber: streamObjNum, Generation: 0})
+	objStream, err := r.GetObject(IndirectObject{ObjectNumber: streamObjNum, Generation: r.xref.Entries[streamObjNum].Generation})
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +454,15 @@ func (r *Reader) getCompressedObject(streamObjNum int, index int) (Object, error
 	if !ok {
 		return nil, errors.New("object stream missing or invalid /N parameter")
 	}
+	// The data is kept here; don't keep a second copy in the stream
+	data, err := stm.detached().Data()
+	if err != nil {
+		return nil, err
+	}
 	n := int(nObj)
+	if n < 0 || n > len(data) {
+		return nil, fmt.Errorf("object stream /N %d is invalid", n)
+	}
 
 	firstObj, ok := stm.Dictionary["/First"].(NumberObject)
 	if !ok {
@@ -328,7 +471,7 @@ func (r *Reader) getCompressedObject(streamObjNum int, index int) (Object, error
 	first := int(firstObj)
 
 	// Create a lexer for the UNCOMPRESSED content
-	stmReader := bytes.NewReader(stm.Data)
+	stmReader := bytes.NewReader(data)
 	stmLexer := NewLexer(stmReader)
 
 	offsets := make([]int, n)
@@ -359,22 +502,16 @@ func (r *Reader) getCompressedObject(streamObjNum int, index int) (Object, error
 		offsets[i] = int(offset)
 	}
 
-	if index < 0 || index >= n {
-		return nil, fmt.Errorf("object index %d out of bounds [0, %d)", index, n)
-	}
-
-	startOffset := int64(first + offsets[index])
-	stmReader.Seek(startOffset, io.SeekStart)
-
-	objLexer := NewLexer(stmReader)
-	return objLexer.ReadObject()
+	objStm := &objectStream{data: data, first: first, offsets: offsets}
+	r.cache.addValue(key, objStm, int64(len(data))+8*int64(n)+64)
+	return objStm, nil
 }
 
 func (r *Reader) Resolve(obj Object) Object {
 	if ref, ok := obj.(IndirectObject); ok {
 		res, err := r.GetObject(ref)
 		if err != nil {
-			fmt.Printf("Warning: failed to resolve object %v: %v\n", ref, err)
+			r.warn("failed to resolve object %v: %v", ref, err)
 			return NullObject{}
 		}
 		return res
@@ -398,6 +535,34 @@ func (r *Reader) IsEncrypted() bool {
 	return exists
 }
 
+// Security returns the encryption settings and decoded permissions,
+// or nil if the document is not encrypted.
+func (r *Reader) Security() *model.Security {
+	h := r.encryptHandler
+	if h == nil {
+		return nil
+	}
+
+	d := h.Dict
+	return &model.Security{
+		Revision:        d.R,
+		Algorithm:       h.Algorithm(),
+		KeyLength:       d.Length,
+		EncryptMetadata: d.EncryptMetadata,
+		OwnerAccess:     h.OwnerAccess,
+		Permissions: model.Permissions{
+			Print:            d.HasPermission(PermPrint),
+			Modify:           d.HasPermission(PermModify),
+			CopyExtract:      d.HasPermission(PermCopyExtract),
+			Annotate:         d.HasPermission(PermAnnotate),
+			FillForms:        d.HasPermission(PermFillForms),
+			Accessibility:    d.HasPermission(PermAccessibility),
+			Assemble:         d.HasPermission(PermAssemble),
+			PrintHighQuality: d.HasPermission(PermPrintHighQuality),
+		},
+	}
+}
+
 // metadataKeys are dictionary keys that should not be encrypted per PDF spec
 var metadataKeys = map[string]bool{
 	"/Type":             true,
@@ -434,14 +599,14 @@ func (r *Reader) decryptObject(obj Object, objNum, genNum int) Object {
 
 	switch v := obj.(type) {
 	case StringObject:
-		decrypted, err := r.encryptHandler.Decrypt([]byte(v), objNum, genNum)
+		decrypted, err := r.encryptHandler.DecryptString([]byte(v), objNum, genNum)
 		if err != nil {
 			return v // Return original on error
 		}
 		return StringObject(decrypted)
 
 	case HexStringObject:
-		decrypted, err := r.encryptHandler.Decrypt([]byte(v), objNum, genNum)
+		decrypted, err := r.encryptHandler.DecryptString([]byte(v), objNum, genNum)
 		if err != nil {
 			return v
 		}
@@ -469,14 +634,17 @@ func (r *Reader) decryptObject(obj Object, objNum, genNum int) Object {
 	}
 }
 
+// GetCachedFont returns the font built from a font dictionary object, if
+// it is still in the object cache.
 func (r *Reader) GetCachedFont(objNum int) *Font {
-	r.fontCacheMu.RLock()
-	defer r.fontCacheMu.RUnlock()
-	return r.fontCache[objNum]
+	if cached, ok := r.cache.getValue(objKey{num: objNum, kind: kindFont}); ok {
+		return cached.(*Font)
+	}
+	return nil
 }
 
+// CacheFont keeps a font in the object cache, which bounds it with the
+// objects.
 func (r *Reader) CacheFont(objNum int, f *Font) {
-	r.fontCacheMu.Lock()
-	defer r.fontCacheMu.Unlock()
-	r.fontCache[objNum] = f
+	r.cache.addValue(objKey{num: objNum, kind: kindFont}, f, fontSize(f))
 }
@@ -0,0 +1,163 @@
+package pdf
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Reading order.
+//
+// Blocks are ordered with a recursive XY-cut: a region is split in two at
+// the widest gap in the projection of its blocks onto either axis, and each
+// part is ordered in turn. Gaps between columns count double, so that
+// paragraph breaks that happen to line up across columns do not cut
+// through them, while a title or footer with empty space beside it is
+// still split off first.
+
+const (
+	columnGap        = 0.5 // Smallest gap between columns, in font sizes
+	columnPreference = 2.0 // Weight of gaps between columns against gaps between rows
+)
+
+// ReadingOrder sorts the blocks of the layout into reading order and
+// returns a confidence in [0, 1]. Words and lines are reordered to follow
+// their blocks. The confidence is lowered by blocks that overlap, which
+// leave the order to a top-to-bottom guess, and by disagreement with the
+// content stream order.
+func (l *Layout) ReadingOrder() float64 {
+	var ordered []*textBlock
+	ambiguous := 0
+	for start := 0; start < len(l.blocks); {
+		end := start
+		for end < len(l.blocks) && l.blocks[end].dir == l.blocks[start].dir {
+			end++
+		}
+		xyCut(l.blocks[start:end], &ordered, &ambiguous)
+		start = end
+	}
+	l.blocks = ordered
+
+	l.Words, l.Lines, l.Blocks = nil, nil, nil
+	for _, b := range l.blocks {
+		for _, line := range b.lines {
+			for _, w := range line.words {
+				l.Words = append(l.Words, span(w.glyphs, wordText(w)))
+			}
+			l.Lines = append(l.Lines, span(lineGlyphs(line), lineText(line)))
+		}
+		l.Blocks = append(l.Blocks, b.span())
+	}
+
+	if len(l.blocks) < 2 {
+		return 1
+	}
+	structure := 1 - float64(ambiguous)/float64(len(l.blocks))
+	confidence := structure * (0.5 + 0.5*streamAgreement(l.blocks))
+	return math.Round(confidence*100) / 100
+}
+
+// Text returns the text of the blocks in their current order, separated
+// by blank lines.
+func (l *Layout) Text() string {
+	texts := make([]string, len(l.Blocks))
+	for i, b := range l.Blocks {
+		texts[i] = b.Text
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// xyCut appends blocks to out in reading order. Blocks left in a region
+// that cannot be cut overlap each other and are counted as ambiguous.
+func xyCut(blocks []*textBlock, out *[]*textBlock, ambiguous *int) {
+	if len(blocks) < 2 {
+		*out = append(*out, blocks...)
+		return
+	}
+
+	// Columns, left to right, or rows, top to bottom
+	left, right, colGap := widestGap(blocks, func(r Rectangle) (float64, float64) { return r.LLX, r.URX })
+	top, bottom, rowGap := widestGap(blocks, func(r Rectangle) (float64, float64) { return -r.URY, -r.LLY })
+	if colGap < columnGap*medianSize(blocks) {
+		left = nil
+	}
+	switch {
+	case left != nil && (top == nil || colGap*columnPreference >= rowGap):
+		xyCut(left, out, ambiguous)
+		xyCut(right, out, ambiguous)
+		return
+	case top != nil:
+		xyCut(top, out, ambiguous)
+		xyCut(bottom, out, ambiguous)
+		return
+	}
+
+	sorted := append([]*textBlock(nil), blocks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].box.URY != sorted[j].box.URY {
+			return sorted[i].box.URY > sorted[j].box.URY
+		}
+		return sorted[i].box.LLX < sorted[j].box.LLX
+	})
+	*out = append(*out, sorted...)
+	*ambiguous += len(sorted)
+}
+
+// widestGap splits blocks at the widest gap between their projections onto
+// an axis. before is nil when the projections leave no gap.
+func widestGap(blocks []*textBlock, interval func(Rectangle) (float64, float64)) (before, after []*textBlock, gap float64) {
+	sorted := append([]*textBlock(nil), blocks...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		lo1, _ := interval(sorted[i].box)
+		lo2, _ := interval(sorted[j].box)
+		return lo1 < lo2
+	})
+
+	split := 0
+	_, reach := interval(sorted[0].box)
+	for i, b := range sorted[1:] {
+		lo, hi := interval(b.box)
+		if lo-reach > gap {
+			split, gap = i+1, lo-reach
+		}
+		reach = math.Max(reach, hi)
+	}
+	if split == 0 {
+		return nil, nil, 0
+	}
+	return sorted[:split], sorted[split:], gap
+}
+
+// medianSize returns the median font size of the blocks' first lines
+func medianSize(blocks []*textBlock) float64 {
+	sizes := make([]float64, len(blocks))
+	for i, b := range blocks {
+		sizes[i] = b.lines[0].size
+	}
+	sort.Float64s(sizes)
+	return sizes[len(sizes)/2]
+}
+
+// streamAgreement returns the fraction of block pairs that the content
+// stream shows in the same order as they are now
+func streamAgreement(blocks []*textBlock) float64 {
+	first := make([]int, len(blocks))
+	for i, b := range blocks {
+		first[i] = math.MaxInt
+		for _, line := range b.lines {
+			for _, g := range lineGlyphs(line) {
+				first[i] = min(first[i], g.OpIndex)
+			}
+		}
+	}
+	agree, pairs := 0, 0
+	for i := range first {
+		for j := i + 1; j < len(first); j++ {
+			pairs++
+			if first[i] <= first[j] {
+				agree++
+			}
+		}
+	}
+	return float64(agree) / float64(pairs)
+}
@@ -0,0 +1,360 @@
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Cross-reference repair.
+//
+// Truncated, re-encoded or hand-edited files often have a missing or wrong
+// startxref, or a table whose offsets no longer point at their objects. Like
+// other viewers we then rebuild the table by scanning the whole file for
+// "N G obj" markers, and rebuild the trailer from every "trailer" dictionary
+// and cross-reference stream we come across.
+
+// objHeader matches an indirect object header, e.g. "12 0 obj"
+var objHeader = regexp.MustCompile(`(\d{1,10})[\x00\t\n\f\r ]+(\d{1,5})[\x00\t\n\f\r ]+obj\b`)
+
+// trailerKeyword matches the start of a trailer dictionary
+var trailerKeyword = regexp.MustCompile(`trailer[\x00\t\n\f\r ]*<<`)
+
+// scannedObject is an object header found by the repair scan
+type scannedObject struct {
+	num, gen int
+	offset   int64
+	body     []byte // Up to the next "endobj", or the next header if there is none
+}
+
+// RepairXRef rebuilds a cross-reference table by scanning the file. The
+// returned table has Repaired set. /Root is taken from the trailers found,
+// or else from the last /Type /Catalog object; it is left unset when the
+// catalog can only be inside an object stream, which the Reader resolves.
+func RepairXRef(rs io.ReadSeeker) (*XRefTable, error) {
+	return repairXRef(rs, defaultLimits)
+}
+
+func repairXRef(rs io.ReadSeeker, lim Limits) (*XRefTable, error) {
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(rs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file for xref repair: %w", err)
+	}
+
+	table := NewXRefTable()
+	table.Repaired = true
+	table.limits = lim
+
+	objects := scanObjects(data)
+	if len(objects) == 0 {
+		return nil, errors.New("xref repair: no objects found")
+	}
+	if err := table.checkEntries(0, len(objects)); err != nil {
+		return nil, err
+	}
+	// Later definitions belong to later incremental updates and win
+	for _, obj := range objects {
+		table.Entries[obj.num] = XRefEntry{Offset: obj.offset, Generation: obj.gen}
+	}
+
+	// Trailer candidates in file order: "trailer" dictionaries and xref streams
+	type candidate struct {
+		offset int64
+		dict   DictionaryObject
+	}
+	var candidates []candidate
+	for _, loc := range trailerKeyword.FindAllIndex(data, -1) {
+		lexer := NewLexer(bytes.NewReader(data[loc[1]-2:]))
+		if dict, ok := readObjectSafe(lexer).(DictionaryObject); ok {
+			candidates = append(candidates, candidate{int64(loc[0]), dict})
+		}
+	}
+	for _, obj := range objects {
+		if !bytes.Contains(obj.body, []byte("/XRef")) {
+			continue
+		}
+		// Compressed entries are only recorded by xref streams; keep the
+		// ones for objects the scan did not find in the open
+		streamTable := NewXRefTable()
+		streamTable.limits, streamTable.decoded = lim, table.decoded
+		_, dict, err := streamTable.readXRefStream(bytes.NewReader(data[obj.offset:]))
+		if errors.Is(err, ErrLimitExceeded) {
+			return nil, err
+		}
+		if err != nil {
+			continue
+		}
+		table.decoded = streamTable.decoded
+		for num, entry := range streamTable.Entries {
+			if _, found := table.Entries[num]; !found && entry.Compressed {
+				table.Entries[num] = entry
+			}
+		}
+		candidates = append(candidates, candidate{obj.offset, dict})
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].offset > candidates[j].offset })
+
+	// Newest trailer first; keys it lacks come from older ones
+	for _, c := range candidates {
+		for k, v := range c.dict {
+			if _, exists := table.Trailer[k]; !exists {
+				table.Trailer[k] = v
+			}
+		}
+	}
+	delete(table.Trailer, "/Prev")
+
+	if !table.validRoot() {
+		delete(table.Trailer, "/Root")
+		for i := len(objects) - 1; i >= 0; i-- {
+			obj := objects[i]
+			if !bytes.Contains(obj.body, []byte("/Catalog")) {
+				continue
+			}
+			if isCatalog(obj.body) {
+				table.Trailer["/Root"] = IndirectObject{ObjectNumber: obj.num, Generation: obj.gen}
+				break
+			}
+		}
+	}
+	return table, nil
+}
+
+// scanObjects finds every indirect object header in data. Stream contents are
+// skipped so that binary data cannot produce false matches. Headers and
+// keywords are each searched for once, front to back, so damaged files
+// without terminators still take linear time.
+func scanObjects(data []byte) []scannedObject {
+	var objects []scannedObject
+	headers := objHeader.FindAllSubmatchIndex(data, -1)
+	endobj := keywordScanner{data: data, keyword: []byte("endobj"), at: -1}
+	endstream := keywordScanner{data: data, keyword: []byte("endstream"), at: -1}
+
+	pos := 0
+	for h, loc := range headers {
+		start, end := loc[0], loc[1]
+		if start < pos {
+			continue // Inside the previous object's stream
+		}
+		// Don't match the tail of a longer number, e.g. "112 0 obj" as "12 0 obj"
+		if start > 0 && data[start-1] >= '0' && data[start-1] <= '9' {
+			continue
+		}
+		num, _ := strconv.Atoi(string(data[loc[2]:loc[3]]))
+		gen, _ := strconv.Atoi(string(data[loc[4]:loc[5]]))
+
+		// The body ends at endobj or at the next header, whichever is first
+		bodyEnd := len(data)
+		if h+1 < len(headers) {
+			bodyEnd = headers[h+1][0]
+		}
+		if i := endobj.next(end); i >= 0 && i < bodyEnd {
+			bodyEnd = i
+		}
+
+		next := bodyEnd
+		if s := bytes.Index(data[end:bodyEnd], []byte("stream")); s >= 0 {
+			// The stream may contain "endobj"; resume after "endstream"
+			if e := endstream.next(end + s); e >= 0 {
+				next = e + len("endstream")
+				bodyEnd = end + s
+			}
+		}
+
+		objects = append(objects, scannedObject{num: num, gen: gen, offset: int64(start), body: data[end:bodyEnd]})
+		pos = next
+	}
+	return objects
+}
+
+// keywordScanner finds the next occurrence of a keyword at or after a
+// position. Positions only move forward, so each byte is searched once.
+type keywordScanner struct {
+	data    []byte
+	keyword []byte
+	at      int // Last match; -1 before the first search
+	done    bool
+}
+
+func (k *keywordScanner) next(from int) int {
+	if k.at >= from {
+		return k.at
+	}
+	if k.done {
+		return -1
+	}
+	i := bytes.Index(k.data[from:], k.keyword)
+	if i < 0 {
+		k.done = true
+		return -1
+	}
+	k.at = from + i
+	return k.at
+}
+
+// validRoot reports whether /Root refers to an object in the table
+func (t *XRefTable) validRoot() bool {
+	ref, ok := t.Trailer["/Root"].(IndirectObject)
+	if !ok {
+		return false
+	}
+	entry, ok := t.Entries[ref.ObjectNumber]
+	return ok && !entry.Free
+}
+
+// isCatalog reports whether an object body is a /Type /Catalog dictionary
+func isCatalog(body []byte) bool {
+	dict, ok := readObjectSafe(NewLexer(bytes.NewReader(body))).(DictionaryObject)
+	if !ok {
+		return false
+	}
+	t, _ := dict["/Type"].(NameObject)
+	return t == "/Catalog"
+}
+
+// readObjectSafe reads one object, returning NullObject on any error
+func readObjectSafe(lexer *Lexer) Object {
+	obj, err := lexer.ReadObject()
+	if err != nil {
+		return NullObject{}
+	}
+	return obj
+}
+
+// objectAt reports whether an "N G obj" header for the given object starts at offset
+func objectAt(rs io.ReadSeeker, offset int64, num int) bool {
+	if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+		return false
+	}
+	buf := make([]byte, 32)
+	n, _ := io.ReadFull(rs, buf)
+	loc := objHeader.FindSubmatchIndex(buf[:n])
+	if loc == nil || loc[0] != 0 {
+		return false
+	}
+	got, _ := strconv.Atoi(string(buf[loc[2]:loc[3]]))
+	return got == num
+}
+
+// indexObjectStreams adds entries for objects stored in object streams, which
+// the repair scan cannot see. Objects found in the open take precedence.
+func (r *Reader) indexObjectStreams() {
+	for _, num := range r.xref.objectNumbers() {
+		entry := r.xref.Entries[num]
+		if entry.Compressed {
+			continue
+		}
+		stm, ok := r.Resolve(IndirectObject{ObjectNumber: num, Generation: entry.Generation}).(StreamObject)
+		if !ok {
+			continue
+		}
+		if t, _ := stm.Dictionary["/Type"].(NameObject); t != "/ObjStm" {
+			continue
+		}
+		n, _ := r.Resolve(stm.Dictionary["/N"]).(NumberObject)
+		data, err := stm.Data()
+		if err != nil {
+			continue
+		}
+		lexer := NewLexer(bytes.NewReader(data))
+		for i := 0; i < int(n); i++ {
+			objNum, ok1 := readObjectSafe(lexer).(NumberObject)
+			_, ok2 := readObjectSafe(lexer).(NumberObject)
+			if !ok1 || !ok2 {
+				break
+			}
+			if _, exists := r.xref.Entries[int(objNum)]; !exists {
+				if r.xref.checkEntries(0, 1) != nil {
+					return
+				}
+				r.xref.Entries[int(objNum)] = XRefEntry{Compressed: true, StreamObj: num, StreamIdx: i}
+			}
+		}
+	}
+}
+
+// findCatalog sets /Root to the last /Type /Catalog object in an object stream
+func (r *Reader) findCatalog() bool {
+	nums := r.xref.objectNumbers()
+	for i := len(nums) - 1; i >= 0; i-- {
+		if !r.xref.Entries[nums[i]].Compressed {
+			continue
+		}
+		ref := IndirectObject{ObjectNumber: nums[i]}
+		if dict, ok := r.Resolve(ref).(DictionaryObject); ok {
+			if t, _ := dict["/Type"].(NameObject); t == "/Catalog" {
+				r.xref.Trailer["/Root"] = ref
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// objectNumbers returns the numbers of the in-use entries in ascending order
+func (t *XRefTable) objectNumbers() []int {
+	nums := make([]int, 0, len(t.Entries))
+	for num, entry := range t.Entries {
+		if !entry.Free {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+	return nums
+}
+
+// endstreamAt reports whether "endstream" follows offset, allowing for the
+// end-of-line marker before it
+func (r *Reader) endstreamAt(offset int64) bool {
+	if _, err := r.rs.Seek(offset, io.SeekStart); err != nil {
+		return false
+	}
+	buf := make([]byte, 32)
+	n, _ := io.ReadFull(r.rs, buf)
+	return bytes.HasPrefix(bytes.TrimLeft(buf[:n], "\x00\t\n\f\r "), []byte("endstream"))
+}
+
+// findEndstream returns the length of the stream starting at offset by
+// searching for the next "endstream". The end-of-line marker before the
+// keyword is not part of the data.
+func (r *Reader) findEndstream(offset int64) (int64, bool) {
+	const chunkSize = 64 * 1024
+	keyword := []byte("endstream")
+	buf := make([]byte, 0, chunkSize+len(keyword))
+	for pos := offset; ; {
+		if _, err := r.rs.Seek(pos, io.SeekStart); err != nil {
+			return 0, false
+		}
+		chunk := make([]byte, chunkSize)
+		n, err := io.ReadFull(r.rs, chunk)
+		// Keep the tail of the previous chunk in case the keyword straddles both
+		buf = append(buf, chunk[:n]...)
+		if i := bytes.Index(buf, keyword); i >= 0 {
+			end := pos - int64(len(buf)-n) + int64(i)
+			data := buf[:i]
+			if bytes.HasSuffix(data, []byte("\r\n")) {
+				end -= 2
+			} else if bytes.HasSuffix(data, []byte("\n")) || bytes.HasSuffix(data, []byte("\r")) {
+				end--
+			}
+			if end < offset {
+				end = offset
+			}
+			return end - offset, true
+		}
+		if err != nil {
+			return 0, false
+		}
+		pos += int64(n)
+		if len(buf) > len(keyword) {
+			buf = append(buf[:0], buf[len(buf)-len(keyword):]...)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package pdf
+
+import "math"
+
+// Ruling lines.
+//
+// Vector graphics are normally skipped. When a RulingSink is set, paths are
+// followed just far enough to report the horizontal and vertical lines that
+// stroke or fill them, which is what table borders are drawn with.
+
+// thinRule is the largest thickness, in points, of a filled rectangle that
+// counts as a line
+const thinRule = 2.5
+
+// axisTolerance is how far, in points, a segment may slope and still count
+// as horizontal or vertical
+const axisTolerance = 1.0
+
+// Ruling is a horizontal or vertical line drawn on a page, in page space.
+type Ruling struct {
+	From, To Point
+}
+
+// Horizontal reports whether the ruling runs along the x axis.
+func (r Ruling) Horizontal() bool {
+	return math.Abs(r.From.Y-r.To.Y) <= axisTolerance && math.Abs(r.From.X-r.To.X) > axisTolerance
+}
+
+// Vertical reports whether the ruling runs along the y axis.
+func (r Ruling) Vertical() bool {
+	return math.Abs(r.From.X-r.To.X) <= axisTolerance && math.Abs(r.From.Y-r.To.Y) > axisTolerance
+}
+
+// pathState is the path under construction, in page space
+type pathState struct {
+	segments []Ruling
+	rects    [][4]Point
+	start    Point // Of the current subpath
+	current  Point
+}
+
+// processPathOp follows path construction and painting operators
+func (e *Extractor) processPathOp(op Operation) {
+	p := &e.path
+	ctm := e.gState.CTM
+	point := func(i int) Point {
+		return ctm.transform(number(op.Operands[i]), number(op.Operands[i+1]))
+	}
+
+	switch op.Operator {
+	case "m":
+		if len(op.Operands) == 2 {
+			p.start = point(0)
+			p.current = p.start
+		}
+	case "l":
+		if len(op.Operands) == 2 {
+			next := point(0)
+			p.segments = append(p.segments, Ruling{p.current, next})
+			p.current = next
+		}
+	case "c":
+		if len(op.Operands) == 6 {
+			p.current = point(4)
+		}
+	case "v", "y":
+		if len(op.Operands) == 4 {
+			p.current = point(2)
+		}
+	case "h":
+		p.segments = append(p.segments, Ruling{p.current, p.start})
+		p.current = p.start
+	case "re":
+		if len(op.Operands) == 4 {
+			x, y := number(op.Operands[0]), number(op.Operands[1])
+			w, h := number(op.Operands[2]), number(op.Operands[3])
+			p.rects = append(p.rects, [4]Point{
+				ctm.transform(x, y), ctm.transform(x+w, y),
+				ctm.transform(x+w, y+h), ctm.transform(x, y+h),
+			})
+			p.start = ctm.transform(x, y)
+			p.current = p.start
+		}
+	case "S", "s", "B", "B*", "b", "b*":
+		if op.Operator == "s" || op.Operator == "b" || op.Operator == "b*" {
+			p.segments = append(p.segments, Ruling{p.current, p.start})
+		}
+		for _, seg := range p.segments {
+			e.emitRuling(seg)
+		}
+		for _, r := range p.rects {
+			for i := range r {
+				e.emitRuling(Ruling{r[i], r[(i+1)%4]})
+			}
+		}
+		e.path = pathState{}
+	case "f", "F", "f*":
+		// Only thin rectangles are lines; wider fills are backgrounds
+		for _, r := range p.rects {
+			box := quadBox(r)
+			switch {
+			case box.Height() <= thinRule && box.Width() > box.Height():
+				y := (box.LLY + box.URY) / 2
+				e.emitRuling(Ruling{Point{box.LLX, y}, Point{box.URX, y}})
+			case box.Width() <= thinRule && box.Height() > box.Width():
+				x := (box.LLX + box.URX) / 2
+				e.emitRuling(Ruling{Point{x, box.LLY}, Point{x, box.URY}})
+			}
+		}
+		e.path = pathState{}
+	case "n":
+		e.path = pathState{}
+	}
+}
+
+// emitRuling passes a segment to the sink if it is horizontal or vertical
+func (e *Extractor) emitRuling(r Ruling) {
+	if r.Horizontal() || r.Vertical() {
+		e.opts.RulingSink(r)
+	}
+}
+
+// quadBox returns the axis-aligned bounding box of four points
+func quadBox(q [4]Point) Rectangle {
+	r := Rectangle{LLX: q[0].X, LLY: q[0].Y, URX: q[0].X, URY: q[0].Y}
+	for _, p := range q[1:] {
+		r.LLX, r.URX = min(r.LLX, p.X), max(r.URX, p.X)
+		r.LLY, r.URY = min(r.LLY, p.Y), max(r.URY, p.Y)
+	}
+	return r
+}
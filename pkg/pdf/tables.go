@@ -0,0 +1,525 @@
+package pdf
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/AOShei/go-fast-pdf/pkg/model"
+)
+
+// Table detection.
+//
+// Ruled tables come from the lines a RulingSink reports: horizontal and
+// vertical rulings that cross each other form a grid, a boundary missing
+// inside the grid joins the cells on either side of it, and each word goes
+// to the cell that contains its centre. Tables without rulings come from
+// text alignment: a run of rows that each split into several lines at wide
+// gaps, with columns where those lines line up. Only horizontal text is
+// considered.
+
+const (
+	rulingTolerance = 2.0 // Distance, in points, within which rulings meet or line up
+	minCellSize     = 3.0 // Grid lines closer than this, in points, are one line
+	maxRowSpacing   = 3.0 // Largest distance between the baselines of table rows, in font sizes
+	minTableRows    = 3   // Rows of an unruled table that split into several lines
+	maxCellWords    = 5.0 // Average words per cell above which aligned text is prose
+)
+
+// tableRegion is a detected table and the lines of text it took
+type tableRegion struct {
+	table model.Table
+	box   Rectangle
+	lines map[*textLine]bool
+}
+
+// DetectTables finds tables among the horizontal text of the layout, using
+// rulings collected with a RulingSink when there are any. Tables are
+// returned top to bottom and are also kept in the layout's Tables.
+func (l *Layout) DetectTables(rulings []Ruling) []model.Table {
+	var lines []*textLine
+	for _, b := range l.blocks {
+		if b.dir == 0 {
+			lines = append(lines, b.lines...)
+		}
+	}
+
+	taken := make(map[*textLine]bool)
+	l.tables = nil
+	for _, grid := range rulingGrids(rulings) {
+		if t := grid.table(lines, taken); t != nil {
+			l.tables = append(l.tables, t)
+		}
+	}
+	l.tables = append(l.tables, alignedTables(lines, taken)...)
+	sort.SliceStable(l.tables, func(i, j int) bool { return l.tables[i].box.URY > l.tables[j].box.URY })
+
+	l.Tables = make([]model.Table, len(l.tables))
+	for i, t := range l.tables {
+		l.Tables[i] = t.table
+	}
+	return l.Tables
+}
+
+// TextWithTables is like Text, but the lines of each detected table are
+// replaced by render's output, placed where the table's first line is.
+func (l *Layout) TextWithTables(render func(model.Table) string) string {
+	owner := make(map[*textLine]*tableRegion)
+	for _, t := range l.tables {
+		for line := range t.lines {
+			owner[line] = t
+		}
+	}
+
+	done := make(map[*tableRegion]bool)
+	var paras, text []string
+	flush := func() {
+		if len(text) > 0 {
+			paras = append(paras, strings.Join(text, "\n"))
+			text = nil
+		}
+	}
+	for _, b := range l.blocks {
+		for _, line := range b.lines {
+			t := owner[line]
+			if t == nil {
+				text = append(text, lineText(line))
+				continue
+			}
+			if !done[t] {
+				done[t] = true
+				flush()
+				paras = append(paras, render(t.table))
+			}
+		}
+		flush()
+	}
+	return strings.Join(paras, "\n\n")
+}
+
+// segment is a horizontal ruling at y = pos from x = lo to hi, or a
+// vertical one at x = pos from y = lo to hi
+type segment struct {
+	pos, lo, hi float64
+}
+
+// rulingGrid is a set of rulings connected by their crossings
+type rulingGrid struct {
+	hs, vs []segment
+}
+
+// rulingGrids merges collinear rulings and groups them by crossings.
+// Groups without at least two rulings each way are dropped.
+func rulingGrids(rulings []Ruling) []rulingGrid {
+	var hs, vs []segment
+	for _, r := range rulings {
+		switch {
+		case r.Horizontal():
+			hs = append(hs, segment{(r.From.Y + r.To.Y) / 2, math.Min(r.From.X, r.To.X), math.Max(r.From.X, r.To.X)})
+		case r.Vertical():
+			vs = append(vs, segment{(r.From.X + r.To.X) / 2, math.Min(r.From.Y, r.To.Y), math.Max(r.From.Y, r.To.Y)})
+		}
+	}
+	hs, vs = mergeSegments(hs), mergeSegments(vs)
+
+	parent := make([]int, len(hs)+len(vs))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	for i, h := range hs {
+		for j, v := range vs {
+			if v.pos >= h.lo-rulingTolerance && v.pos <= h.hi+rulingTolerance &&
+				h.pos >= v.lo-rulingTolerance && h.pos <= v.hi+rulingTolerance {
+				parent[find(i)] = find(len(hs) + j)
+			}
+		}
+	}
+
+	groups := make(map[int]*rulingGrid)
+	var roots []int
+	group := func(i int) *rulingGrid {
+		root := find(i)
+		if groups[root] == nil {
+			groups[root] = &rulingGrid{}
+			roots = append(roots, root)
+		}
+		return groups[root]
+	}
+	for i, h := range hs {
+		g := group(i)
+		g.hs = append(g.hs, h)
+	}
+	for j, v := range vs {
+		g := group(len(hs) + j)
+		g.vs = append(g.vs, v)
+	}
+
+	var grids []rulingGrid
+	for _, root := range roots {
+		if g := groups[root]; len(g.hs) >= 2 && len(g.vs) >= 2 {
+			grids = append(grids, *g)
+		}
+	}
+	return grids
+}
+
+// mergeSegments joins segments that lie on the same line and overlap or
+// touch, such as the edges of adjacent cells drawn as separate rectangles
+func mergeSegments(segs []segment) []segment {
+	sort.Slice(segs, func(i, j int) bool {
+		if segs[i].pos != segs[j].pos {
+			return segs[i].pos < segs[j].pos
+		}
+		return segs[i].lo < segs[j].lo
+	})
+	var out []segment
+	for _, s := range segs {
+		merged := false
+		for i := len(out) - 1; i >= 0 && s.pos-out[i].pos <= rulingTolerance; i-- {
+			o := &out[i]
+			if s.lo <= o.hi+rulingTolerance && s.hi >= o.lo-rulingTolerance {
+				o.lo, o.hi = math.Min(o.lo, s.lo), math.Max(o.hi, s.hi)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// positions returns the distinct positions of segments, in increasing order
+func positions(segs []segment) []float64 {
+	ps := make([]float64, len(segs))
+	for i, s := range segs {
+		ps[i] = s.pos
+	}
+	sort.Float64s(ps)
+	var out []float64
+	for _, p := range ps {
+		if len(out) == 0 || p-out[len(out)-1] > minCellSize {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// covers reports whether a segment near pos runs through at
+func covers(segs []segment, pos, at float64) bool {
+	for _, s := range segs {
+		if math.Abs(s.pos-pos) <= minCellSize && at >= s.lo-rulingTolerance && at <= s.hi+rulingTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// gridCell is a cell of a ruled table with the words inside it
+type gridCell struct {
+	row, col, rows, cols int
+	box                  Rectangle
+	words                []cellWord
+}
+
+type cellWord struct {
+	word *textWord
+	row  int // Of the word's line
+}
+
+// table lays the grid out as cells and fills them with the lines whose
+// centres fall inside it. It returns nil unless the grid has at least two
+// columns and two cells with text.
+func (g rulingGrid) table(lines []*textLine, taken map[*textLine]bool) *tableRegion {
+	xs := positions(g.vs)
+	ys := positions(g.hs)
+	sort.Sort(sort.Reverse(sort.Float64Slice(ys))) // Top to bottom
+	if len(xs) < 3 || len(ys) < 2 {
+		return nil
+	}
+	nr, nc := len(ys)-1, len(xs)-1
+
+	// Each cell starts at its top-left grid position and extends right and
+	// down across missing boundaries
+	owner := make([][]*gridCell, nr)
+	for r := range owner {
+		owner[r] = make([]*gridCell, nc)
+	}
+	var cells []*gridCell
+	for r := 0; r < nr; r++ {
+		midY := (ys[r] + ys[r+1]) / 2
+		for c := 0; c < nc; c++ {
+			if owner[r][c] != nil {
+				continue
+			}
+			cols := 1
+			for c+cols < nc && owner[r][c+cols] == nil && !covers(g.vs, xs[c+cols], midY) {
+				cols++
+			}
+			rows := 1
+		down:
+			for r+rows < nr {
+				for k := c; k < c+cols; k++ {
+					if owner[r+rows][k] != nil || covers(g.hs, ys[r+rows], (xs[k]+xs[k+1])/2) {
+						break down
+					}
+				}
+				rows++
+			}
+			cell := &gridCell{row: r, col: c, rows: rows, cols: cols,
+				box: Rectangle{xs[c], ys[r+rows], xs[c+cols], ys[r]}}
+			for i := r; i < r+rows; i++ {
+				for k := c; k < c+cols; k++ {
+					owner[i][k] = cell
+				}
+			}
+			cells = append(cells, cell)
+		}
+	}
+
+	box := Rectangle{xs[0], ys[nr], xs[nc], ys[0]}
+	region := &tableRegion{box: box, lines: make(map[*textLine]bool)}
+	for _, line := range lines {
+		if taken[line] || !inside(box, line.box) {
+			continue
+		}
+		region.lines[line] = true
+		for _, w := range line.words {
+			cx, cy := (w.box.LLX+w.box.URX)/2, (w.box.LLY+w.box.URY)/2
+			c := sort.Search(nc, func(i int) bool { return xs[i+1] > cx })
+			r := sort.Search(nr, func(i int) bool { return ys[i+1] < cy })
+			cell := owner[min(r, nr-1)][min(c, nc-1)]
+			cell.words = append(cell.words, cellWord{w, line.row})
+		}
+	}
+
+	filled := 0
+	for _, cell := range cells {
+		if len(cell.words) > 0 {
+			filled++
+		}
+	}
+	if filled < 2 {
+		return nil
+	}
+	for line := range region.lines {
+		taken[line] = true
+	}
+
+	region.table = model.Table{BBox: box.Array(), Columns: nc, Ruled: true, Rows: make([]model.TableRow, nr)}
+	for r := range region.table.Rows {
+		region.table.Rows[r].Cells = []model.TableCell{}
+	}
+	for _, cell := range cells {
+		sort.SliceStable(cell.words, func(i, j int) bool {
+			a, b := cell.words[i], cell.words[j]
+			if a.row != b.row {
+				return a.row < b.row
+			}
+			return a.word.box.LLX < b.word.box.LLX
+		})
+		texts := make([]string, len(cell.words))
+		for i, cw := range cell.words {
+			texts[i] = wordText(cw.word)
+		}
+		row := &region.table.Rows[cell.row]
+		row.Cells = append(row.Cells, model.TableCell{
+			Text:    strings.Join(texts, " "),
+			BBox:    cell.box.Array(),
+			Column:  cell.col,
+			RowSpan: cell.rows,
+			ColSpan: cell.cols,
+		})
+	}
+	return region
+}
+
+// inside reports whether the centre of r lies within box
+func inside(box, r Rectangle) bool {
+	cx, cy := (r.LLX+r.URX)/2, (r.LLY+r.URY)/2
+	return cx >= box.LLX && cx <= box.URX && cy >= box.LLY && cy <= box.URY
+}
+
+// alignedTables finds tables in runs of rows that split into several
+// lines. A row with a single line, such as a section label, may sit
+// between two split rows.
+func alignedTables(lines []*textLine, taken map[*textLine]bool) []*tableRegion {
+	byRow := make(map[int][]*textLine)
+	for _, line := range lines {
+		if !taken[line] {
+			byRow[line.row] = append(byRow[line.row], line)
+		}
+	}
+	keys := make([]int, 0, len(byRow))
+	for k := range byRow {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	rows := make([][]*textLine, len(keys))
+	for i, k := range keys {
+		rows[i] = byRow[k]
+		sort.SliceStable(rows[i], func(a, b int) bool { return rows[i][a].box.LLX < rows[i][b].box.LLX })
+	}
+
+	var tables []*tableRegion
+	for i := 0; i < len(rows); {
+		if len(rows[i]) < 2 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(rows) && rowsAdjacent(rows[j-1], rows[j]) {
+			if len(rows[j]) < 2 && (j+1 == len(rows) || len(rows[j+1]) < 2 || !rowsAdjacent(rows[j], rows[j+1])) {
+				break
+			}
+			j++
+		}
+		if t := alignedTable(rows[i:j]); t != nil {
+			tables = append(tables, t)
+		}
+		i = j
+	}
+	return tables
+}
+
+// rowsAdjacent reports whether row b follows row a closely enough to be
+// part of the same table
+func rowsAdjacent(a, b []*textLine) bool {
+	size := math.Max(a[0].size, b[0].size)
+	return a[0].words[0].base()-b[0].words[0].base() <= maxRowSpacing*size
+}
+
+// alignedTable builds a table from a run of rows. Columns are the x ranges
+// covered by the lines of the most common kind of row, widened by lines of
+// other rows that fall outside all of them; a line covering several ranges
+// spans those columns.
+func alignedTable(rows [][]*textLine) *tableRegion {
+	split, words, segs := 0, 0, 0
+	counts := make(map[int]int)
+	for _, row := range rows {
+		if len(row) < 2 {
+			continue
+		}
+		split++
+		counts[len(row)]++
+		for _, line := range row {
+			words += len(line.words)
+			segs++
+		}
+	}
+	if split < minTableRows || float64(words)/float64(segs) > maxCellWords {
+		return nil
+	}
+	common := 0
+	for n, c := range counts {
+		if c > counts[common] || (c == counts[common] && n > common) {
+			common = n
+		}
+	}
+
+	var ranges []segment
+	for _, row := range rows {
+		if len(row) == common {
+			for _, line := range row {
+				ranges = append(ranges, segment{lo: line.box.LLX, hi: line.box.URX})
+			}
+		}
+	}
+	ranges = mergeRanges(ranges)
+	for _, row := range rows {
+		for _, line := range row {
+			if first, _ := overlapping(ranges, line.box); first < 0 && len(row) > 1 {
+				ranges = mergeRanges(append(ranges, segment{lo: line.box.LLX, hi: line.box.URX}))
+			}
+		}
+	}
+	if len(ranges) < 2 {
+		return nil
+	}
+
+	region := &tableRegion{lines: make(map[*textLine]bool)}
+	region.table = model.Table{Columns: len(ranges), Rows: make([]model.TableRow, len(rows))}
+	for r, row := range rows {
+		var cells []model.TableCell
+		var boxes []Rectangle
+		last := -1
+		for _, line := range row {
+			first, end := overlapping(ranges, line.box)
+			if first < 0 {
+				first = nearest(ranges, line.box)
+				end = first
+			}
+			if n := len(cells); n > 0 && first <= last {
+				// Same column as the previous line
+				cells[n-1].Text += " " + lineText(line)
+				boxes[n-1] = union(boxes[n-1], line.box)
+				last = max(last, end)
+				cells[n-1].ColSpan = last - cells[n-1].Column + 1
+			} else {
+				cells = append(cells, model.TableCell{Text: lineText(line), Column: first, RowSpan: 1, ColSpan: end - first + 1})
+				boxes = append(boxes, line.box)
+				last = end
+			}
+			if len(region.lines) == 0 {
+				region.box = line.box
+			}
+			region.lines[line] = true
+			region.box = union(region.box, line.box)
+		}
+		for i := range cells {
+			cells[i].BBox = boxes[i].Array()
+		}
+		region.table.Rows[r].Cells = cells
+	}
+	region.table.BBox = region.box.Array()
+	return region
+}
+
+// mergeRanges sorts x ranges and joins the overlapping ones
+func mergeRanges(ranges []segment) []segment {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	var out []segment
+	for _, r := range ranges {
+		if n := len(out); n > 0 && r.lo <= out[n-1].hi {
+			out[n-1].hi = math.Max(out[n-1].hi, r.hi)
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// overlapping returns the first and last ranges that box overlaps
+// horizontally, or -1, -1
+func overlapping(ranges []segment, box Rectangle) (first, last int) {
+	first, last = -1, -1
+	for i, r := range ranges {
+		if box.LLX < r.hi && box.URX > r.lo {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	return first, last
+}
+
+// nearest returns the range closest to the centre of box
+func nearest(ranges []segment, box Rectangle) int {
+	cx := (box.LLX + box.URX) / 2
+	best, bestDist := 0, math.Inf(1)
+	for i, r := range ranges {
+		if d := math.Max(r.lo-cx, cx-r.hi); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best
+}
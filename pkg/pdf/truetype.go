@@ -0,0 +1,265 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// TrueType / OpenType font programs (/FontFile2, /FontFile3 /OpenType).
+// Only the tables needed to recover text are read: cmap, post and CFF.
+
+var errBadTrueType = errors.New("pdf: malformed TrueType font program")
+
+// cmapSubtable identifies a cmap subtable by platform and encoding ID
+type cmapSubtable struct {
+	platform, encoding uint16
+}
+
+// parseTrueType reads the cmap and post tables (or the CFF table of an
+// OpenType font) of an sfnt font program.
+func parseTrueType(data []byte) (*fontProgram, error) {
+	if len(data) < 12 {
+		return nil, errBadTrueType
+	}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	if 12+numTables*16 > len(data) {
+		return nil, errBadTrueType
+	}
+
+	tables := make(map[string][]byte, numTables)
+	for i := 0; i < numTables; i++ {
+		rec := data[12+i*16:]
+		tag := string(rec[:4])
+		off := int(binary.BigEndian.Uint32(rec[8:]))
+		length := int(binary.BigEndian.Uint32(rec[12:]))
+		if off < 0 || length < 0 || off > len(data) || length > len(data)-off {
+			continue
+		}
+		tables[tag] = data[off : off+length]
+	}
+
+	prog := &fontProgram{}
+	if cff, ok := tables["CFF "]; ok {
+		if p, err := parseCFF(cff); err == nil {
+			prog = p
+		}
+	}
+	if post, ok := tables["post"]; ok {
+		if names := parsePost(post); len(names) > 0 {
+			prog.glyphNames = names
+		}
+	}
+
+	cmaps := parseCmapTable(tables["cmap"])
+	prog.gidUnicode = make(map[int]rune)
+	for _, sub := range []cmapSubtable{{3, 10}, {0, 4}, {0, 3}, {3, 1}} {
+		for code, gid := range cmaps[sub] {
+			if _, seen := prog.gidUnicode[gid]; !seen || code < uint32(prog.gidUnicode[gid]) {
+				prog.gidUnicode[gid] = rune(code)
+			}
+		}
+	}
+
+	// Built-in encoding for symbolic simple fonts (PDF 32000-1 9.6.6.4):
+	// codes select glyphs through the (3,0) subtable, else the (1,0) subtable
+	var enc [256]string
+	found := false
+	for code := 0; code < 256; code++ {
+		gid, ok := 0, false
+		if sym := cmaps[cmapSubtable{3, 0}]; sym != nil {
+			for _, prefix := range []uint32{0xF000, 0xF100, 0xF200, 0x0000} {
+				if gid, ok = sym[prefix|uint32(code)]; ok {
+					break
+				}
+			}
+		} else if mac := cmaps[cmapSubtable{1, 0}]; mac != nil {
+			gid, ok = mac[uint32(code)]
+		}
+		if !ok || gid == 0 {
+			continue
+		}
+		if name := prog.glyphName(gid); name != "" {
+			enc[code] = name
+			found = true
+		}
+	}
+	if found {
+		prog.encoding = &enc
+	}
+	return prog, nil
+}
+
+// parseCmapTable reads the format 0, 4, 6 and 12 subtables of a cmap table
+func parseCmapTable(data []byte) map[cmapSubtable]map[uint32]int {
+	out := make(map[cmapSubtable]map[uint32]int)
+	if len(data) < 4 {
+		return out
+	}
+	n := int(binary.BigEndian.Uint16(data[2:]))
+	for i := 0; i < n && 4+i*8+8 <= len(data); i++ {
+		rec := data[4+i*8:]
+		sub := cmapSubtable{binary.BigEndian.Uint16(rec), binary.BigEndian.Uint16(rec[2:])}
+		off := int(binary.BigEndian.Uint32(rec[4:]))
+		if off < 0 || off+2 > len(data) {
+			continue
+		}
+		if m := parseCmapSubtable(data[off:]); len(m) > 0 {
+			out[sub] = m
+		}
+	}
+	return out
+}
+
+// maxCmapCodes bounds the codes read from one cmap subtable: a small
+// crafted table can otherwise describe billions of mappings
+const maxCmapCodes = 0x110000
+
+func parseCmapSubtable(data []byte) map[uint32]int {
+	m := make(map[uint32]int)
+	u16 := func(off int) int {
+		if off < 0 || off+2 > len(data) {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(data[off:]))
+	}
+	u32 := func(off int) uint32 {
+		if off < 0 || off+4 > len(data) {
+			return 0
+		}
+		return binary.BigEndian.Uint32(data[off:])
+	}
+
+	switch u16(0) {
+	case 0: // Byte encoding table
+		for code := 0; code < 256 && 6+code < len(data); code++ {
+			if gid := int(data[6+code]); gid != 0 {
+				m[uint32(code)] = gid
+			}
+		}
+	case 4: // Segment mapping to delta values
+		segX2 := u16(6)
+		ends, starts, deltas, ranges := 14, 16+segX2, 16+2*segX2, 16+3*segX2
+		for s := 0; s < segX2; s += 2 {
+			end, start := u16(ends+s), u16(starts+s)
+			delta, rangeOff := u16(deltas+s), u16(ranges+s)
+			if end < start || start == 0xFFFF {
+				continue
+			}
+			for c := start; c <= end; c++ {
+				gid := 0
+				if rangeOff == 0 {
+					gid = (c + delta) & 0xFFFF
+				} else if g := u16(ranges + s + rangeOff + 2*(c-start)); g != 0 {
+					gid = (g + delta) & 0xFFFF
+				}
+				if gid != 0 {
+					m[uint32(c)] = gid
+				}
+			}
+		}
+	case 6: // Trimmed table mapping
+		first, count := u16(6), u16(8)
+		for i := 0; i < count; i++ {
+			if gid := u16(10 + 2*i); gid != 0 {
+				m[uint32(first+i)] = gid
+			}
+		}
+	case 12: // Segmented coverage
+		groups := int(u32(12))
+		total := 0
+		for i := 0; i < groups && 16+i*12+12 <= len(data); i++ {
+			start, end, gid := u32(16+i*12), u32(20+i*12), u32(24+i*12)
+			// Skip absurd groups in damaged fonts
+			if end < start || end-start > 0xFFFF {
+				continue
+			}
+			// Groups may overlap, so bound the codes visited rather than mapped
+			if total += int(end-start) + 1; total > maxCmapCodes {
+				break
+			}
+			for c := start; c <= end; c++ {
+				m[c] = int(gid + c - start)
+			}
+		}
+	}
+	return m
+}
+
+// parsePost returns glyph names from a version 1 or 2 post table
+func parsePost(data []byte) map[int]string {
+	if len(data) < 32 {
+		return nil
+	}
+	names := make(map[int]string)
+	switch binary.BigEndian.Uint32(data) {
+	case 0x00010000:
+		for gid, name := range macGlyphNames {
+			names[gid] = "/" + name
+		}
+	case 0x00020000:
+		if len(data) < 34 {
+			return nil
+		}
+		numGlyphs := int(binary.BigEndian.Uint16(data[32:]))
+		if 34+2*numGlyphs > len(data) {
+			return nil
+		}
+		// Pascal strings follow the index array
+		var custom []string
+		for p := 34 + 2*numGlyphs; p < len(data); {
+			n := int(data[p])
+			if p+1+n > len(data) {
+				break
+			}
+			custom = append(custom, string(data[p+1:p+1+n]))
+			p += 1 + n
+		}
+		for gid := 0; gid < numGlyphs; gid++ {
+			idx := int(binary.BigEndian.Uint16(data[34+2*gid:]))
+			switch {
+			case idx < len(macGlyphNames):
+				names[gid] = "/" + macGlyphNames[idx]
+			case idx-len(macGlyphNames) < len(custom):
+				names[gid] = "/" + custom[idx-len(macGlyphNames)]
+			}
+		}
+	}
+	return names
+}
+
+// macGlyphNames is the standard Macintosh glyph order used by post tables
+var macGlyphNames = [258]string{
+	".notdef", ".null", "nonmarkingreturn", "space", "exclam", "quotedbl", "numbersign", "dollar",
+	"percent", "ampersand", "quotesingle", "parenleft", "parenright", "asterisk", "plus", "comma",
+	"hyphen", "period", "slash", "zero", "one", "two", "three", "four",
+	"five", "six", "seven", "eight", "nine", "colon", "semicolon", "less",
+	"equal", "greater", "question", "at", "A", "B", "C", "D",
+	"E", "F", "G", "H", "I", "J", "K", "L",
+	"M", "N", "O", "P", "Q", "R", "S", "T",
+	"U", "V", "W", "X", "Y", "Z", "bracketleft", "backslash",
+	"bracketright", "asciicircum", "underscore", "grave", "a", "b", "c", "d",
+	"e", "f", "g", "h", "i", "j", "k", "l",
+	"m", "n", "o", "p", "q", "r", "s", "t",
+	"u", "v", "w", "x", "y", "z", "braceleft", "bar",
+	"braceright", "asciitilde", "Adieresis", "Aring", "Ccedilla", "Eacute", "Ntilde", "Odieresis",
+	"Udieresis", "aacute", "agrave", "acircumflex", "adieresis", "atilde", "aring", "ccedilla",
+	"eacute", "egrave", "ecircumflex", "edieresis", "iacute", "igrave", "icircumflex", "idieresis",
+	"ntilde", "oacute", "ograve", "ocircumflex", "odieresis", "otilde", "uacute", "ugrave",
+	"ucircumflex", "udieresis", "dagger", "degree", "cent", "sterling", "section", "bullet",
+	"paragraph", "germandbls", "registered", "copyright", "trademark", "acute", "dieresis", "notequal",
+	"AE", "Oslash", "infinity", "plusminus", "lessequal", "greaterequal", "yen", "mu",
+	"partialdiff", "summation", "product", "pi", "integral", "ordfeminine", "ordmasculine", "Omega",
+	"ae", "oslash", "questiondown", "exclamdown", "logicalnot", "radical", "florin", "approxequal",
+	"Delta", "guillemotleft", "guillemotright", "ellipsis", "nonbreakingspace", "Agrave", "Atilde", "Otilde",
+	"OE", "oe", "endash", "emdash", "quotedblleft", "quotedblright", "quoteleft", "quoteright",
+	"divide", "lozenge", "ydieresis", "Ydieresis", "fraction", "currency", "guilsinglleft", "guilsinglright",
+	"fi", "fl", "daggerdbl", "periodcentered", "quotesinglbase", "quotedblbase", "perthousand", "Acircumflex",
+	"Ecircumflex", "Aacute", "Edieresis", "Egrave", "Iacute", "Icircumflex", "Idieresis", "Igrave",
+	"Oacute", "Ocircumflex", "apple", "Ograve", "Uacute", "Ucircumflex", "Ugrave", "dotlessi",
+	"circumflex", "tilde", "macron", "breve", "dotaccent", "ring", "cedilla", "hungarumlaut",
+	"ogonek", "caron", "Lslash", "lslash", "Scaron", "scaron", "Zcaron", "zcaron",
+	"brokenbar", "Eth", "eth", "Yacute", "yacute", "Thorn", "thorn", "minus",
+	"multiply", "onesuperior", "twosuperior", "threesuperior", "onehalf", "onequarter", "threequarters", "franc",
+	"Gbreve", "gbreve", "Idotaccent", "Scedilla", "scedilla", "Cacute", "cacute", "Ccaron",
+	"ccaron", "dcroat",
+}
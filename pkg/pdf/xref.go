@@ -2,7 +2,6 @@ package pdf
 
 import (
 	"bytes"
-	"compress/zlib"
 	"errors"
 	"fmt"
 	"io"
@@ -22,17 +21,82 @@ type XRefEntry struct {
 type XRefTable struct {
 	Entries map[int]XRefEntry
 	Trailer DictionaryObject
+
+	// Repaired is set when the table was rebuilt by scanning the file
+	// because the cross-reference data was missing or unusable.
+	Repaired bool
+
+	limits  Limits
+	decoded int64 // Bytes inflated from xref streams, for MaxTotalDecoded
 }
 
 func NewXRefTable() *XRefTable {
 	return &XRefTable{
 		Entries: make(map[int]XRefEntry),
 		Trailer: make(DictionaryObject),
+		limits:  defaultLimits,
+	}
+}
+
+// checkEntries fails if a subsection of count entries starting at object
+// start would take the table past its entry limit
+func (t *XRefTable) checkEntries(start, count int) error {
+	if start < 0 || count < 0 {
+		return errors.New("malformed xref subsection")
+	}
+	max := t.limits.MaxXRefEntries
+	if max >= 0 && (count > max || len(t.Entries)+count > max) {
+		return &LimitError{Limit: "MaxXRefEntries", Max: int64(max)}
 	}
+	return nil
 }
 
+// ParseXRef reads the cross-reference table chain of a file. If the chain
+// cannot be read, has no /Root, or /Root does not point at its object, the
+// table is rebuilt with RepairXRef instead.
 func ParseXRef(rs io.ReadSeeker) (*XRefTable, error) {
+	return parseXRef(rs, defaultLimits)
+}
+
+func parseXRef(rs io.ReadSeeker, lim Limits) (*XRefTable, error) {
+	table, err := parseXRefChain(rs, lim)
+	if err == nil && table.rootAtOffset(rs) {
+		return table, nil
+	}
+	if errors.Is(err, ErrLimitExceeded) {
+		return nil, err
+	}
+	repaired, repairErr := repairXRef(rs, lim)
+	if repairErr != nil {
+		if err == nil {
+			err = errors.New("/Root object is not at its xref offset")
+		}
+		return nil, fmt.Errorf("%w (repair failed: %v)", err, repairErr)
+	}
+	return repaired, nil
+}
+
+// rootAtOffset checks that the catalog is where the table says it is, a
+// cheap test for tables whose offsets have all been shifted
+func (t *XRefTable) rootAtOffset(rs io.ReadSeeker) bool {
+	ref, ok := t.Trailer["/Root"].(IndirectObject)
+	if !ok {
+		return false
+	}
+	entry, ok := t.Entries[ref.ObjectNumber]
+	if !ok || entry.Free {
+		return false
+	}
+	if entry.Compressed {
+		return true
+	}
+	return objectAt(rs, entry.Offset, ref.ObjectNumber)
+}
+
+// parseXRefChain follows startxref and the /Prev chain
+func parseXRefChain(rs io.ReadSeeker, lim Limits) (*XRefTable, error) {
 	table := NewXRefTable()
+	table.limits = lim
 	nextOffset, err := findStartXRef(rs)
 	if err != nil {
 		return nil, fmt.Errorf("findStartXRef failed: %w", err)
@@ -95,32 +159,38 @@ func ParseXRef(rs io.ReadSeeker) (*XRefTable, error) {
 	return table, nil
 }
 
+// findStartXRef returns the offset after the last "startxref" keyword. The
+// tail of the file is searched first; files with trailing garbage are
+// searched in progressively larger windows back to the start.
 func findStartXRef(rs io.ReadSeeker) (int64, error) {
 	size, err := rs.Seek(0, io.SeekEnd)
 	if err != nil {
 		return 0, err
 	}
-	readSize := int64(1024)
-	if size < readSize {
-		readSize = size
-	}
-	rs.Seek(-readSize, io.SeekEnd)
-
-	buf := make([]byte, readSize)
-	io.ReadFull(rs, buf)
-
-	idx := bytes.LastIndex(buf, []byte("startxref"))
-	if idx == -1 {
-		return 0, errors.New("startxref not found")
-	}
+	for readSize := int64(1024); ; readSize *= 16 {
+		if size < readSize {
+			readSize = size
+		}
+		if _, err := rs.Seek(-readSize, io.SeekEnd); err != nil {
+			return 0, err
+		}
+		buf := make([]byte, readSize)
+		if _, err := io.ReadFull(rs, buf); err != nil {
+			return 0, err
+		}
 
-	content := string(buf[idx+9:])
-	content = strings.TrimSpace(content)
-	end := 0
-	for end < len(content) && content[end] >= '0' && content[end] <= '9' {
-		end++
+		if idx := bytes.LastIndex(buf, []byte("startxref")); idx != -1 {
+			content := strings.TrimSpace(string(buf[idx+9:]))
+			end := 0
+			for end < len(content) && content[end] >= '0' && content[end] <= '9' {
+				end++
+			}
+			return strconv.ParseInt(content[:end], 10, 64)
+		}
+		if readSize == size {
+			return 0, errors.New("startxref not found")
+		}
 	}
-	return strconv.ParseInt(content[:end], 10, 64)
 }
 
 func (t *XRefTable) readStandardXRef(rs io.ReadSeeker) (int64, DictionaryObject, error) {
@@ -165,6 +235,9 @@ func (t *XRefTable) readStandardXRef(rs io.ReadSeeker) (int64, DictionaryObject,
 
 		start := int(startNum)
 		count := int(countNum)
+		if err := t.checkEntries(start, count); err != nil {
+			return 0, nil, err
+		}
 		lexer.skipWhitespace()
 
 		// IMPORTANT: Read from lexer.reader (not rs) to avoid buffering issues.
@@ -204,19 +277,23 @@ func (t *XRefTable) readStandardXRef(rs io.ReadSeeker) (int64, DictionaryObject,
 	}
 
 	var prev int64
-	if p, ok := tr["/Prev"]; ok {
-		prev = int64(p.(NumberObject))
+	if p, ok := tr["/Prev"].(NumberObject); ok {
+		prev = int64(p)
 	}
 	return prev, tr, nil
 }
 
 func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, error) {
+	size, err := fileSize(rs)
+	if err != nil {
+		return 0, nil, err
+	}
 	lexer := NewLexer(rs)
 	var streamDict DictionaryObject
 
 	// 1. Read the indirect object header: "objNum gen obj"
 	// XRef streams are indirect objects, so skip: objNum, gen, "obj"
-	_, err := lexer.ReadObject() // Skip object number
+	_, err = lexer.ReadObject() // Skip object number
 	if err != nil {
 		return 0, nil, fmt.Errorf("failed reading xref stream obj number: %w", err)
 	}
@@ -250,7 +327,7 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 
 	// 2. Prepare parameters
 	lengthObj, ok := streamDict["/Length"].(NumberObject)
-	if !ok {
+	if !ok || lengthObj < 0 {
 		return 0, nil, errors.New("XRef stream missing /Length")
 	}
 
@@ -259,15 +336,23 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 	if !ok || len(wArr) != 3 {
 		return 0, nil, errors.New("invalid /W array")
 	}
-	w := []int{int(wArr[0].(NumberObject)), int(wArr[1].(NumberObject)), int(wArr[2].(NumberObject))}
+	w := []int{int(number(wArr[0])), int(number(wArr[1])), int(number(wArr[2]))}
+	for _, width := range w {
+		if width < 0 || width > 8 {
+			return 0, nil, errors.New("invalid /W array")
+		}
+	}
 	stride := w[0] + w[1] + w[2]
+	if stride == 0 {
+		return 0, nil, errors.New("invalid /W array")
+	}
 
 	// /Index [ 0 12 ] -> Start, Count (pairs)
 	// Default is [0 Size]
 	var index []int
 	if idxObj, ok := streamDict["/Index"].(ArrayObject); ok {
 		for _, v := range idxObj {
-			index = append(index, int(v.(NumberObject)))
+			index = append(index, int(number(v)))
 		}
 	} else {
 		if sizeObj, ok := streamDict["/Size"].(NumberObject); ok {
@@ -285,17 +370,12 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 
 	// IMPORTANT: Read from lexer.reader (not rs) to avoid buffering issues
 	// The lexer has buffered data, so reading from rs would skip buffered content
-	compressedData := make([]byte, int64(lengthObj))
-	if _, err := io.ReadFull(lexer.reader, compressedData); err != nil {
-		return 0, nil, fmt.Errorf("failed to read compressed stream data: %w", err)
-	}
-
-	zr, err := zlib.NewReader(bytes.NewReader(compressedData))
+	// /Length is read up to the end of the file, never allocated up front
+	compressedData, err := io.ReadAll(io.LimitReader(lexer.reader, min(int64(lengthObj), size)))
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, fmt.Errorf("failed to read compressed stream data: %w", err)
 	}
-	defer zr.Close()
-	decoded, err := io.ReadAll(zr)
+	decoded, err := t.inflate(compressedData)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -326,7 +406,7 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 		}
 
 		var err error
-		decoded, err = applyPngPredictor(decoded, columns, predictor)
+		decoded, err = applyPngPredictor(decoded, columns, 1)
 		if err != nil {
 			return 0, nil, err
 		}
@@ -334,9 +414,17 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 
 	// 5. Parse entries
 	reader := bytes.NewReader(decoded)
-	for i := 0; i < len(index); i += 2 {
+	for i := 0; i+1 < len(index); i += 2 {
 		start := index[i]
 		count := index[i+1]
+		// A truncated stream holds fewer entries than /Index claims; stop
+		// at the last complete one instead of reading zeros past the end
+		if available := reader.Len() / stride; count > available {
+			count = available
+		}
+		if err := t.checkEntries(start, count); err != nil {
+			return 0, nil, err
+		}
 
 		for j := 0; j < count; j++ {
 			// Read 3 fields of widths w[0], w[1], w[2]
@@ -363,12 +451,41 @@ func (t *XRefTable) readXRefStream(rs io.ReadSeeker) (int64, DictionaryObject, e
 	}
 
 	var prev int64
-	if p, ok := streamDict["/Prev"]; ok {
-		prev = int64(p.(NumberObject))
+	if p, ok := streamDict["/Prev"].(NumberObject); ok {
+		prev = int64(p)
 	}
 	return prev, streamDict, nil
 }
 
+// inflate decodes xref stream data within the stream size limits and
+// counts it against MaxTotalDecoded
+func (t *XRefTable) inflate(data []byte) ([]byte, error) {
+	budget, exceeded := t.limits.streamBudget(t.decoded)
+	decoded, err := flateDecode(data, nil, budget)
+	if err == errTooLarge {
+		return nil, exceeded
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.decoded += int64(len(decoded))
+	return decoded, nil
+}
+
+// fileSize returns the size of rs, leaving its position unchanged
+func fileSize(rs io.ReadSeeker) (int64, error) {
+	pos, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	_, err = rs.Seek(pos, io.SeekStart)
+	return size, err
+}
+
 // readField reads `width` bytes as a big-endian integer
 func readField(r io.Reader, width int) int64 {
 	if width == 0 {
@@ -383,93 +500,3 @@ func readField(r io.Reader, width int) int64 {
 	}
 	return res
 }
-
-// applyPngPredictor decodes PNG predicted data (Predictor >= 10)
-// Simplified for PNG Up (12) which is most common in PDFs.
-func applyPngPredictor(data []byte, columns int, predictor int) ([]byte, error) {
-	// Validate predictor is in PNG range (10-15 per PDF spec)
-	if predictor < 10 || predictor > 15 {
-		return nil, fmt.Errorf("unsupported predictor: %d (expected 10-15)", predictor)
-	}
-
-	// Row size = columns + 1 (filter byte)
-	rowSize := columns + 1
-	if len(data)%rowSize != 0 {
-		// It might be loose, but let's warn/ignore
-	}
-
-	rowCount := len(data) / rowSize
-	out := make([]byte, rowCount*columns)
-
-	// Previous row buffer (initially zero)
-	prevRow := make([]byte, columns)
-
-	for i := 0; i < rowCount; i++ {
-		rowStart := i * rowSize
-		filter := data[rowStart]
-		rowBytes := data[rowStart+1 : rowStart+rowSize]
-
-		// Target slice in output
-		outStart := i * columns
-		outRow := out[outStart : outStart+columns]
-
-		switch filter {
-		case 0: // None
-			copy(outRow, rowBytes)
-		case 1: // Sub (Left)
-			var left byte = 0
-			for x := 0; x < columns; x++ {
-				val := rowBytes[x] + left
-				outRow[x] = val
-				left = val
-			}
-		case 2: // Up
-			for x := 0; x < columns; x++ {
-				outRow[x] = rowBytes[x] + prevRow[x]
-			}
-		case 3: // Average
-			var left byte = 0
-			for x := 0; x < columns; x++ {
-				avg := (int(left) + int(prevRow[x])) / 2
-				val := byte(int(rowBytes[x]) + avg)
-				outRow[x] = val
-				left = val
-			}
-		case 4: // Paeth
-			var left byte = 0
-			var upperLeft byte = 0
-			for x := 0; x < columns; x++ {
-				upper := prevRow[x]
-				val := byte(int(rowBytes[x]) + paethPredictor(int(left), int(upper), int(upperLeft)))
-				outRow[x] = val
-				left = val
-				upperLeft = upper
-			}
-		default: // Fallback treat as None
-			copy(outRow, rowBytes)
-		}
-
-		copy(prevRow, outRow)
-	}
-	return out, nil
-}
-
-func paethPredictor(a, b, c int) int {
-	p := a + b - c
-	pa := abs(p - a)
-	pb := abs(p - b)
-	pc := abs(p - c)
-	if pa <= pb && pa <= pc {
-		return a
-	} else if pb <= pc {
-		return b
-	}
-	return c
-}
-
-func abs(x int) int {
-	if x < 0 {
-		return -x
-	}
-	return x
-}